@@ -24,6 +24,7 @@ import (
 	"syscall"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/config"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
@@ -55,6 +56,7 @@ func main() {
 	agentGrpcPort := fs.Int("agent-grpc-port", globals.GPUAgentPort, "Agent GRPC port")
 	versionOpt := fs.Bool("version", false, "show version")
 	bindAddr := fs.String("bind", "0.0.0.0", "bind address for metrics server (default: 0.0.0.0)")
+	validateConfig := fs.String("validate-config", "", "validate the config file, print the errors and exit")
 
 	// Parse with error handling
 	err := fs.Parse(os.Args[1:])
@@ -85,6 +87,25 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *validateConfig != "" {
+		_, warnings, err := config.ValidateConfigFile(*validateConfig)
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "%v: warning: %v\n", *validateConfig, w)
+		}
+		if err != nil {
+			if verrs, ok := err.(config.ValidationErrors); ok {
+				for _, verr := range verrs {
+					fmt.Fprintf(os.Stderr, "%v: %v\n", *validateConfig, verr)
+				}
+			} else {
+				fmt.Fprintf(os.Stderr, "%v: %v\n", *validateConfig, err)
+			}
+			os.Exit(1)
+		}
+		fmt.Printf("%v: config is valid\n", *validateConfig)
+		os.Exit(0)
+	}
+
 	if (0 >= *agentGrpcPort) || (*agentGrpcPort > 65535) {
 		fmt.Printf("invalid agent-grpc-port exiting")
 		os.Exit(1)
@@ -99,6 +120,7 @@ func main() {
 
 	exporterHandler := exporter.NewExporter(*agentGrpcPort, *metricsConfig,
 		exporter.WithBindAddr(*bindAddr),
+		exporter.WithBuildInfo(Version, GitCommit, BuildDate),
 	)
 
 	enableDebugAPI := true // default
@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
@@ -30,6 +31,7 @@ import (
 const (
 	AMDLogicalDevicePrefix = "amdgpu_xcp_"
 	AMDGPURenderStartID    = 128
+	pciDevicesPath         = "/sys/bus/pci/devices"
 )
 
 var (
@@ -94,6 +96,23 @@ func getAllUsedVRAM() (map[string]float64, error) {
 	return result, nil
 }
 
+// getPCIDeviceID reads the PCI device id of the device at the bus address,
+// e.g. 0x74a1
+func getPCIDeviceID(busID string) (string, error) {
+	if busID == "" {
+		return "", fmt.Errorf("bus id is empty")
+	}
+	data, err := os.ReadFile(filepath.Join(pciDevicesPath, busID, "device"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read device id: %w", err)
+	}
+	deviceID := strings.ToLower(strings.TrimSpace(string(data)))
+	if deviceID == "" {
+		return "", fmt.Errorf("device id is empty for %v", busID)
+	}
+	return deviceID, nil
+}
+
 // FindAMDGPUDevices scans the system for AMDGPU XCP devices and returns a map
 // where the key is "gpu_id" and value is device name "amdgpu_xcp_N"
 func FindAMDGPUDevices() (map[string]string, error) {
@@ -136,8 +155,9 @@ func FindAMDGPUDevices() (map[string]string, error) {
 }
 
 type FsysDevice struct {
-	mu      sync.Mutex
-	lgpuMap map[string]string
+	mu        sync.Mutex
+	lgpuMap   map[string]string
+	deviceIDs map[string]string // pci bus id -> device id, "" if unreadable
 }
 
 var FsysDeviceHandler *FsysDevice
@@ -145,7 +165,8 @@ var FsysDeviceHandler *FsysDevice
 func GetFsysDeviceHandler() *FsysDevice {
 	if FsysDeviceHandler == nil {
 		FsysDeviceHandler = &FsysDevice{
-			lgpuMap: make(map[string]string),
+			lgpuMap:   make(map[string]string),
+			deviceIDs: make(map[string]string),
 		}
 		FsysDeviceHandler.init()
 	}
@@ -179,3 +200,23 @@ func (fs *FsysDevice) GetUsedVRAM(nodeid string) (float64, error) {
 func (fs *FsysDevice) GetAllUsedVRAM() (map[string]float64, error) {
 	return getAllUsedVRAM()
 }
+
+// GetPCIDeviceID returns the PCI device id of the device at the bus address,
+// the id doesn't change so lookups are cached including failed ones
+func (fs *FsysDevice) GetPCIDeviceID(busID string) (string, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	deviceID, ok := fs.deviceIDs[busID]
+	if !ok {
+		var err error
+		deviceID, err = getPCIDeviceID(busID)
+		if err != nil {
+			logger.Log.Printf("pci device id lookup failed, %v", err)
+		}
+		fs.deviceIDs[busID] = deviceID
+	}
+	if deviceID == "" {
+		return "", fmt.Errorf("device id not found for %v", busID)
+	}
+	return deviceID, nil
+}
@@ -0,0 +1,171 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package fsysdevice
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultProcfsRoot = "/proc"
+	DefaultSysfsRoot  = "/sys"
+)
+
+var (
+	// systemd and cgroupfs container scopes end with the 64 hex digits id,
+	// e.g. cri-containerd-<id>.scope, docker-<id>.scope or /docker/<id>
+	containerIDRe = regexp.MustCompile(`([0-9a-f]{64})(?:\.scope)?$`)
+	// kubepods-besteffort-pod<uid>.slice with the systemd driver, dashes of
+	// the uid are underscores
+	podUIDRe = regexp.MustCompile(`pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})`)
+	// /slurm/uid_<uid>/job_<id>/step_<n> or slurmstepd.scope/job_<id>
+	slurmJobRe = regexp.MustCompile(`/job_(\d+)(?:/|$)`)
+)
+
+// KFDProcess is a process using a gpu through the kfd
+type KFDProcess struct {
+	Pid  uint32
+	Name string // comm
+	Uid  string // real uid
+	// workload of the process cgroup, empty when not found
+	ContainerID string
+	PodUID      string
+	JobID       string
+	// usage of the gpu
+	UsedVRAM    float64 // MB
+	SDMAUsage   float64 // microseconds
+	CUOccupancy float64 // compute units
+}
+
+// KFDProcessReader reads the kfd processes from a procfs and a sysfs root,
+// e.g. /host/proc when the host procfs is mounted there
+type KFDProcessReader struct {
+	procRoot string
+	sysRoot  string
+}
+
+// NewKFDProcessReader returns a reader of the roots, empty roots are the
+// defaults
+func NewKFDProcessReader(procRoot, sysRoot string) *KFDProcessReader {
+	if procRoot == "" {
+		procRoot = DefaultProcfsRoot
+	}
+	if sysRoot == "" {
+		sysRoot = DefaultSysfsRoot
+	}
+	return &KFDProcessReader{procRoot: procRoot, sysRoot: sysRoot}
+}
+
+// readTrimmed returns the file content without surrounding spaces
+func readTrimmed(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// readFloat reads a file holding a single number
+func readFloat(path string) (float64, error) {
+	val, err := readTrimmed(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(val, 64)
+}
+
+// GetKFDGPUID returns the kfd gpu id of the topology node, the per process
+// usage files are named after it
+func (r *KFDProcessReader) GetKFDGPUID(nodeid uint32) (string, error) {
+	path := filepath.Join(r.sysRoot, "class/kfd/kfd/topology/nodes", fmt.Sprintf("%v", nodeid), "gpu_id")
+	gpuid, err := readTrimmed(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read kfd gpu id: %w", err)
+	}
+	if gpuid == "" || gpuid == "0" {
+		return "", fmt.Errorf("node %v is not a gpu", nodeid)
+	}
+	return gpuid, nil
+}
+
+// GetProcess returns the process and its usage of the kfd gpu, an error if
+// the process is gone
+func (r *KFDProcessReader) GetProcess(pid uint32, kfdGPUID string) (*KFDProcess, error) {
+	procDir := filepath.Join(r.procRoot, fmt.Sprintf("%v", pid))
+	name, err := readTrimmed(filepath.Join(procDir, "comm"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read process %v: %w", pid, err)
+	}
+	p := &KFDProcess{Pid: pid, Name: name}
+	p.Uid, _ = readUid(filepath.Join(procDir, "status"))
+	if cgroups, err := os.ReadFile(filepath.Join(procDir, "cgroup")); err == nil {
+		p.ContainerID, p.PodUID, p.JobID = parseCgroups(string(cgroups))
+	}
+
+	kfdDir := filepath.Join(r.sysRoot, "class/kfd/kfd/proc", fmt.Sprintf("%v", pid))
+	if vram, err := readFloat(filepath.Join(kfdDir, "vram_"+kfdGPUID)); err == nil {
+		p.UsedVRAM = vram / (1024 * 1024)
+	}
+	p.SDMAUsage, _ = readFloat(filepath.Join(kfdDir, "sdma_"+kfdGPUID))
+	p.CUOccupancy, _ = readFloat(filepath.Join(kfdDir, "stats_"+kfdGPUID, "cu_occupancy"))
+	return p, nil
+}
+
+// readUid returns the real uid of a /proc/<pid>/status file
+func readUid(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 1 && fields[0] == "Uid:" {
+			return fields[1], nil
+		}
+	}
+	return "", fmt.Errorf("no uid in %v", path)
+}
+
+// parseCgroups returns the container id, pod uid and slurm job id found in
+// the cgroup paths of a /proc/<pid>/cgroup file
+func parseCgroups(cgroups string) (containerID, podUID, jobID string) {
+	for _, line := range strings.Split(cgroups, "\n") {
+		// hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		path := parts[2]
+		if m := containerIDRe.FindStringSubmatch(path); m != nil && containerID == "" {
+			containerID = m[1]
+		}
+		if m := podUIDRe.FindStringSubmatch(path); m != nil && podUID == "" {
+			podUID = strings.ReplaceAll(m[1], "_", "-")
+		}
+		if m := slurmJobRe.FindStringSubmatch(path); m != nil && jobID == "" {
+			jobID = m[1]
+		}
+	}
+	return
+}
@@ -0,0 +1,100 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package fsysdevice
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+const containerID = "3f4b5e0c9a1d2e7f8b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a"
+
+// writeTree writes the files of a fake procfs and sysfs tree under root
+func writeTree(t *testing.T, root string, files map[string]string) {
+	for path, content := range files {
+		path = filepath.Join(root, path)
+		assert.Assert(t, os.MkdirAll(filepath.Dir(path), 0755) == nil)
+		assert.Assert(t, os.WriteFile(path, []byte(content), 0644) == nil)
+	}
+}
+
+func TestKFDProcess(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"sys/class/kfd/kfd/topology/nodes/0/gpu_id":           "0\n",
+		"sys/class/kfd/kfd/topology/nodes/2/gpu_id":           "48153\n",
+		"sys/class/kfd/kfd/proc/100/vram_48153":               "2097152\n",
+		"sys/class/kfd/kfd/proc/100/sdma_48153":               "1500\n",
+		"sys/class/kfd/kfd/proc/100/stats_48153/cu_occupancy": "38\n",
+		"proc/100/comm":   "python3\n",
+		"proc/100/status": "Name:\tpython3\nUid:\t1000\t1000\t1000\t1000\nGid:\t1000\t1000\t1000\t1000\n",
+		"proc/100/cgroup": "0::/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod0b1c2d3e_4f5a_6b7c_8d9e_0f1a2b3c4d5e.slice/cri-containerd-" + containerID + ".scope\n",
+		"proc/200/comm":   "train\n",
+		"proc/200/cgroup": "12:memory:/slurm/uid_1000/job_4242/step_0/task_0\n11:cpuset:/slurm/uid_1000/job_4242/step_0\n",
+	})
+	r := NewKFDProcessReader(filepath.Join(root, "proc"), filepath.Join(root, "sys"))
+
+	_, err := r.GetKFDGPUID(0)
+	assert.Assert(t, err != nil, "expecting an error for a cpu node")
+	gpuid, err := r.GetKFDGPUID(2)
+	assert.Assert(t, err == nil, "%v", err)
+	assert.Equal(t, gpuid, "48153")
+
+	p, err := r.GetProcess(100, gpuid)
+	assert.Assert(t, err == nil, "%v", err)
+	assert.DeepEqual(t, *p, KFDProcess{
+		Pid:         100,
+		Name:        "python3",
+		Uid:         "1000",
+		ContainerID: containerID,
+		PodUID:      "0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e",
+		UsedVRAM:    2,
+		SDMAUsage:   1500,
+		CUOccupancy: 38,
+	})
+
+	// no kfd usage files yet
+	p, err = r.GetProcess(200, gpuid)
+	assert.Assert(t, err == nil, "%v", err)
+	assert.DeepEqual(t, *p, KFDProcess{Pid: 200, Name: "train", JobID: "4242"})
+
+	_, err = r.GetProcess(300, gpuid)
+	assert.Assert(t, err != nil, "expecting an error for an exited process")
+}
+
+func TestParseCgroups(t *testing.T) {
+	for _, tc := range []struct {
+		cgroup      string
+		containerID string
+		podUID      string
+		jobID       string
+	}{
+		{cgroup: "0::/system.slice/docker-" + containerID + ".scope", containerID: containerID},
+		{cgroup: "4:pids:/kubepods/burstable/pod0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e/" + containerID,
+			containerID: containerID, podUID: "0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e"},
+		{cgroup: "0::/system.slice/slurmstepd.scope/job_17/step_batch/user/task_0", jobID: "17"},
+		{cgroup: "0::/user.slice/user-1000.slice/session-3.scope"},
+	} {
+		containerID, podUID, jobID := parseCgroups(tc.cgroup)
+		assert.Equal(t, containerID, tc.containerID, tc.cgroup)
+		assert.Equal(t, podUID, tc.podUID, tc.cgroup)
+		assert.Equal(t, jobID, tc.jobID, tc.cgroup)
+	}
+}
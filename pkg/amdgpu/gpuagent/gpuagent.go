@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
@@ -33,8 +34,10 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/metricsutil"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -43,6 +46,8 @@ const (
 	refreshInterval = 30 * time.Second
 	queryTimeout    = 15 * time.Second
 	cacheTimer      = 15 * time.Second
+	// health states are stale after this many failed polls in a row
+	healthPollFailureLimit = 3
 )
 
 type GPUAgentClient struct {
@@ -51,6 +56,7 @@ type GPUAgentClient struct {
 	mh                     *metricsutil.MetricsHandler
 	gpuclient              amdgpu.GPUSvcClient
 	evtclient              amdgpu.EventSvcClient
+	debugclient            amdgpu.DebugGPUSvcClient
 	rocpclient             *rocprofiler.ROCProfilerClient
 	m                      *metrics // client specific metrics
 	k8sApiClient           *k8sclient.K8sClient
@@ -63,10 +69,19 @@ type GPUAgentClient struct {
 	ctx                    context.Context
 	cancel                 context.CancelFunc
 	healthState            map[string]*metricssvc.GPUState
-	mockEccField           map[string]map[string]uint32 // gpuid->fields->count
+	healthWatch            func(states []*metricssvc.GPUState, removed []string) // health state changes
+	notifyMu               sync.Mutex                                            // orders the health watch calls
+	mockEccField           map[string]map[string]uint32                          // gpuid->fields->count
 	computeNodeHealthState bool
+	healthPollFailures     int // consecutive failed health polls
 	fsysDeviceHandler      *fsysdevice.FsysDevice
 	gCache                 *gpuCache
+	bpCache                *badPageCache
+	procReader             *fsysdevice.KFDProcessReader
+	derived                *derivedTracker
+	metricsSnapshot        atomic.Pointer[metricSet]                     // served on collect
+	workloads              atomic.Pointer[map[string]scheduler.Workload] // last listed by the collector
+	counters               *counterTracker
 }
 
 // Cache fields for GPUAgentClient
@@ -74,18 +89,21 @@ type gpuCache struct {
 	sync.RWMutex
 	lastResponse  *amdgpu.GPUGetResponse
 	lastTimestamp time.Time
+	lastSuccess   time.Time
 }
 
-func initclients(mh *metricsutil.MetricsHandler) (conn *grpc.ClientConn, gpuclient amdgpu.GPUSvcClient, evtclient amdgpu.EventSvcClient, err error) {
+func initclients(mh *metricsutil.MetricsHandler) (conn *grpc.ClientConn, gpuclient amdgpu.GPUSvcClient, evtclient amdgpu.EventSvcClient, debugclient amdgpu.DebugGPUSvcClient, err error) {
 	agentAddr := mh.GetAgentAddr()
 	logger.Log.Printf("Agent connecting to %v", agentAddr)
-	conn, err = grpc.NewClient(agentAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err = grpc.NewClient(agentAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(selfmetrics.AgentUnaryInterceptor))
 	if err != nil {
 		logger.Log.Printf("err :%v", err)
 		return
 	}
 	gpuclient = amdgpu.NewGPUSvcClient(conn)
 	evtclient = amdgpu.NewEventSvcClient(conn)
+	debugclient = amdgpu.NewDebugGPUSvcClient(conn)
 	return
 }
 
@@ -97,7 +115,11 @@ func NewAgent(mh *metricsutil.MetricsHandler, k8sclient *k8sclient.K8sClient, en
 	ga.enableProfileMetrics = true
 	ga.k8sApiClient = k8sclient
 	ga.fsysDeviceHandler = fsysdevice.GetFsysDeviceHandler()
+	ga.procReader = fsysdevice.NewKFDProcessReader("", "")
+	ga.derived = newDerivedTracker()
 	ga.gCache = &gpuCache{}
+	ga.bpCache = &badPageCache{}
+	ga.counters = newCounterTracker()
 	mh.RegisterMetricsClient(ga)
 	return ga
 }
@@ -106,7 +128,7 @@ func (ga *GPUAgentClient) Init() error {
 	ga.Lock()
 	defer ga.Unlock()
 	ga.initializeContext()
-	conn, gpuclient, evtclient, err := initclients(ga.mh)
+	conn, gpuclient, evtclient, debugclient, err := initclients(ga.mh)
 	if err != nil {
 		logger.Log.Printf("gpu client init failure err :%v", err)
 		return err
@@ -115,6 +137,7 @@ func (ga *GPUAgentClient) Init() error {
 	ga.conn = conn
 	ga.gpuclient = gpuclient
 	ga.evtclient = evtclient
+	ga.debugclient = debugclient
 
 	if utils.IsKubernetes() {
 		ga.isKubernetes = true
@@ -156,6 +179,80 @@ func (ga *GPUAgentClient) isActive() bool {
 	return ga.gpuclient != nil
 }
 
+// CheckConnection returns an error when gpuagent is not connected
+func (ga *GPUAgentClient) CheckConnection() error {
+	ga.Lock()
+	defer ga.Unlock()
+	if ga.gpuclient == nil || ga.conn == nil {
+		return fmt.Errorf("gpuagent client not initialized")
+	}
+	if state := ga.conn.GetState(); state == connectivity.TransientFailure || state == connectivity.Shutdown {
+		return fmt.Errorf("gpuagent connection %v", state)
+	}
+	return nil
+}
+
+// CheckLastGPUGet returns an error when no GPUGet succeeded within maxAge
+func (ga *GPUAgentClient) CheckLastGPUGet(maxAge time.Duration) error {
+	ga.gCache.RLock()
+	defer ga.gCache.RUnlock()
+	if ga.gCache.lastSuccess.IsZero() {
+		return fmt.Errorf("no successful GPUGet yet")
+	}
+	if age := time.Since(ga.gCache.lastSuccess); age > maxAge {
+		return fmt.Errorf("last successful GPUGet %v ago", age.Truncate(time.Second))
+	}
+	return nil
+}
+
+// lastGPUGetTime returns when the served GPUGet response was read, cached
+// responses keep the time of their read
+func (ga *GPUAgentClient) lastGPUGetTime() time.Time {
+	ga.gCache.RLock()
+	defer ga.gCache.RUnlock()
+	return ga.gCache.lastTimestamp
+}
+
+// CheckSlurmWatcher returns an error when the slurm job watcher is down
+func (ga *GPUAgentClient) CheckSlurmWatcher() error {
+	ga.Lock()
+	defer ga.Unlock()
+	if ga.slurmScheduler == nil {
+		return fmt.Errorf("slurm scheduler not initialized")
+	}
+	return ga.slurmScheduler.Healthy()
+}
+
+// CheckHealthPoll returns an error when the last healthPollFailureLimit
+// health polls failed
+func (ga *GPUAgentClient) CheckHealthPoll() error {
+	ga.Lock()
+	defer ga.Unlock()
+	if ga.healthPollFailures >= healthPollFailureLimit {
+		return fmt.Errorf("last %v health polls failed", ga.healthPollFailures)
+	}
+	return nil
+}
+
+// CheckHealth returns an error when the health states aren't current, the
+// gpuagent is disconnected or the health polls keep failing
+func (ga *GPUAgentClient) CheckHealth() error {
+	if err := ga.CheckConnection(); err != nil {
+		return err
+	}
+	return ga.CheckHealthPoll()
+}
+
+func (ga *GPUAgentClient) setHealthPollResult(err error) {
+	ga.Lock()
+	defer ga.Unlock()
+	if err != nil {
+		ga.healthPollFailures++
+		return
+	}
+	ga.healthPollFailures = 0
+}
+
 func (ga *GPUAgentClient) StartMonitor() {
 	logger.Log.Printf("GPUAgent monitor started")
 	ga.initializeContext()
@@ -169,12 +266,17 @@ func (ga *GPUAgentClient) StartMonitor() {
 			if !ga.isActive() {
 				if err := ga.reconnect(); err != nil {
 					logger.Log.Printf("gpuagent connection failed %v", err)
+					ga.setHealthPollResult(err)
 					continue
 				}
 			}
-			if err := ga.processHealthValidation(); err != nil {
+			start := time.Now()
+			err := ga.processHealthValidation()
+			if err != nil {
 				logger.Log.Printf("gpuagent health validation failed %v", err)
 			}
+			ga.setHealthPollResult(err)
+			selfmetrics.ObserveHealthPoll(start)
 			if err := ga.sendNodeLabelUpdate(); err != nil {
 				logger.Log.Printf("gpuagent failed to send node label update %v", err)
 			}
@@ -240,7 +342,7 @@ func (ga *GPUAgentClient) getProfilerMetrics() (map[string]map[string]float64, e
 	return gpuMetrics, nil
 }
 
-func (ga *GPUAgentClient) getMetricsAll() error {
+func (ga *GPUAgentClient) getMetricsAll(ms *metricSet) error {
 	// send the req to gpuclient
 	resp, partitionMap, err := ga.getGPUs()
 	if err != nil {
@@ -250,7 +352,10 @@ func (ga *GPUAgentClient) getMetricsAll() error {
 		logger.Log.Printf("resp status :%v", resp.ApiStatus)
 		return fmt.Errorf("%v", resp.ApiStatus)
 	}
-	wls, _ := ga.ListWorkloads()
+	wls, err := ga.ListWorkloads()
+	if err == nil {
+		ga.workloads.Store(&wls)
+	}
 	pmetrics, err := ga.getProfilerMetrics()
 	if err != nil {
 		//continue as this may not be available at this time
@@ -264,8 +369,10 @@ func (ga *GPUAgentClient) getMetricsAll() error {
 	if err != nil {
 		logger.Log.Printf("GetAllUsedVRAM failed with err : %v", err)
 	}
+	badPages := ga.getBadPages()
+	ga.derived.observe(resp.Response, ga.lastGPUGetTime())
 	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
-	ga.m.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(resp.Response)))
+	ms.set(ga.m.gpuNodesTotal, nonGpuLabels, float64(len(resp.Response)))
 	for _, gpu := range resp.Response {
 		var gpuProfMetrics map[string]float64
 		// if available use the data
@@ -274,7 +381,7 @@ func (ga *GPUAgentClient) getMetricsAll() error {
 			//nolint
 			gpuProfMetrics, _ = pmetrics[gpuid]
 		}
-		ga.updateGPUInfoToMetrics(wls, gpu, partitionMap, gpuProfMetrics, usedVRAM)
+		ga.updateGPUInfoToMetrics(ms, wls, gpu, partitionMap, gpuProfMetrics, usedVRAM, badPages)
 	}
 
 	return nil
@@ -292,6 +399,7 @@ func (ga *GPUAgentClient) cacheRead() (*amdgpu.GPUGetResponse, error) {
 	if ga.gCache.lastResponse != nil && now.Sub(ga.gCache.lastTimestamp) < cacheTimer {
 		res := ga.gCache.lastResponse
 		ga.gCache.RUnlock()
+		selfmetrics.CacheHit(selfmetrics.CacheGPU)
 		logger.Log.Printf("returning metrics from cache")
 		return res, nil
 	}
@@ -303,9 +411,11 @@ func (ga *GPUAgentClient) cacheRead() (*amdgpu.GPUGetResponse, error) {
 
 	// Check again after acquiring Lock to handle the case where another goroutine has already updated the cache
 	if ga.gCache.lastResponse != nil && time.Since(ga.gCache.lastTimestamp) < cacheTimer {
+		selfmetrics.CacheHit(selfmetrics.CacheGPU)
 		logger.Log.Printf("returning metrics from cache (after double-check)")
 		return ga.gCache.lastResponse, nil
 	}
+	selfmetrics.CacheMiss(selfmetrics.CacheGPU)
 
 	// Perform query and update cache
 	ctx, cancel := context.WithTimeout(ga.ctx, queryTimeout)
@@ -315,6 +425,7 @@ func (ga *GPUAgentClient) cacheRead() (*amdgpu.GPUGetResponse, error) {
 	ga.gCache.lastTimestamp = time.Now()
 	if err == nil {
 		ga.gCache.lastResponse = res
+		ga.gCache.lastSuccess = ga.gCache.lastTimestamp
 	} else {
 		ga.gCache.lastResponse = nil
 	}
@@ -408,6 +519,7 @@ func (ga *GPUAgentClient) Close() {
 		logger.Log.Printf("gpuagent client closing")
 		ga.conn.Close()
 		ga.gpuclient = nil
+		ga.debugclient = nil
 		ga.conn = nil
 	}
 	if ga.k8sScheduler != nil {
@@ -0,0 +1,145 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/restapi"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+var apiMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+func apiJSON(m proto.Message) []byte {
+	if !m.ProtoReflect().IsValid() {
+		return nil
+	}
+	data, err := apiMarshaler.Marshal(m)
+	if err != nil {
+		logger.Log.Printf("api marshal of %T failed, %v", m, err)
+		return nil
+	}
+	return data
+}
+
+func apiWorkload(wl *scheduler.Workload) restapi.Workload {
+	if wl.Type == scheduler.Kubernetes {
+		podInfo, _ := wl.Info.(scheduler.PodResourceInfo)
+		return restapi.Workload{
+			Type:      restapi.WorkloadKubernetes,
+			Pod:       podInfo.Pod,
+			Namespace: podInfo.Namespace,
+			Container: podInfo.Container,
+		}
+	}
+	jobInfo, _ := wl.Info.(scheduler.JobInfo)
+	return restapi.Workload{
+		Type:         restapi.WorkloadSlurm,
+		JobID:        jobInfo.Id,
+		JobUser:      jobInfo.User,
+		JobPartition: jobInfo.Partition,
+		Cluster:      jobInfo.Cluster,
+	}
+}
+
+// cachedWorkloads returns the workloads last listed by the collector, they
+// are listed once when there was no collection yet
+func (ga *GPUAgentClient) cachedWorkloads() map[string]scheduler.Workload {
+	if wls := ga.workloads.Load(); wls != nil {
+		return *wls
+	}
+	wls, err := ga.ListWorkloads()
+	if err != nil {
+		logger.Log.Printf("Error listing workloads: %v", err)
+	}
+	return wls
+}
+
+// ListGPUs returns the normalized snapshot of the GPUs served by the REST
+// API, built from the cached GPUGet response and workloads
+func (ga *GPUAgentClient) ListGPUs() ([]*restapi.GPU, error) {
+	resp, partitionMap, err := ga.getGPUs()
+	if err != nil {
+		return nil, fmt.Errorf("gpuagent get failed, %v", err)
+	}
+	if resp.ApiStatus != 0 {
+		return nil, fmt.Errorf("gpuagent get failed, status %v", resp.ApiStatus)
+	}
+	wls := ga.cachedWorkloads()
+
+	ga.Lock()
+	health := make(map[string]restapi.Health, len(ga.healthState))
+	for id, state := range ga.healthState {
+		health[id] = restapi.Health{State: state.Health, Reasons: state.HealthReasons, ErrorCounts: state.ErrorCounts}
+	}
+	ga.Unlock()
+
+	gpus := make([]*restapi.GPU, 0, len(resp.Response))
+	for _, gpu := range resp.Response {
+		if gpu.Spec == nil || gpu.Status == nil {
+			continue
+		}
+		guuid, _ := uuid.FromBytes(gpu.Spec.Id)
+		// serial number, model and partition types are the ones of the
+		// physical gpu
+		parent := gpu
+		pcieBusID := ""
+		if gpu.Status.PCIeStatus != nil {
+			pcieBusID = strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId)
+			if p, ok := partitionMap[utils.GetPCIeBaseAddress(pcieBusID)]; ok {
+				parent = p
+			}
+		}
+		g := &restapi.GPU{
+			ID:           fmt.Sprintf("%v", getGPUInstanceID(gpu)),
+			UUID:         guuid.String(),
+			SerialNumber: parent.Status.SerialNum,
+			CardModel:    parent.Status.CardModel,
+			PCIeBusID:    pcieBusID,
+			Partition: restapi.Partition{
+				ID: gpu.Status.PartitionId,
+				ComputePartitionType: strings.ToLower(strings.TrimPrefix(
+					parent.Spec.ComputePartitionType.String(), "GPU_COMPUTE_PARTITION_TYPE_")),
+				MemoryPartitionType: strings.ToLower(strings.TrimPrefix(
+					parent.Spec.MemoryPartitionType.String(), "GPU_MEMORY_PARTITION_TYPE_")),
+			},
+			Health:    restapi.Health{State: restapi.HealthUnknown},
+			Workloads: []restapi.Workload{},
+			Spec:      apiJSON(gpu.Spec),
+			Status:    apiJSON(gpu.Status),
+			Stats:     apiJSON(gpu.Stats),
+		}
+		if h, ok := health[g.ID]; ok {
+			g.Health = h
+		}
+		if wl := ga.getWorkloadInfo(wls, gpu); wl != nil {
+			g.Workloads = append(g.Workloads, apiWorkload(wl))
+		}
+		gpus = append(gpus, g)
+	}
+	return gpus, nil
+}
+
+var _ restapi.Source = (*GPUAgentClient)(nil)
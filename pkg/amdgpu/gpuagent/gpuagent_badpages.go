@@ -0,0 +1,169 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
+)
+
+const (
+	// bad pages only change on ecc events and the stream walks every retired
+	// page of every gpu, so it is refreshed far less often than GPUGet
+	badPageRefreshInterval = 5 * time.Minute
+	// a failed refresh is retried sooner, the health threshold depends on it
+	badPageRetryInterval = 30 * time.Second
+)
+
+// badPageCounts are the bad page counts of a gpu by page status
+type badPageCounts map[amdgpu.GPUPageStatus]int
+
+// total returns the number of bad pages of all states
+func (c badPageCounts) total() int {
+	n := 0
+	for _, count := range c {
+		n += count
+	}
+	return n
+}
+
+// badPageCache holds the counts of the last GPUBadPageGet stream
+type badPageCache struct {
+	sync.Mutex
+	counts      map[string]badPageCounts // gpu uuid
+	nextRefresh time.Time
+	refreshing  bool // a stream is being read
+}
+
+// pageStatusLabels are the page_status label values of the exported states
+var pageStatusLabels = map[amdgpu.GPUPageStatus]string{
+	amdgpu.GPUPageStatus_GPU_PAGE_STATUS_RESERVED:     "reserved",
+	amdgpu.GPUPageStatus_GPU_PAGE_STATUS_PENDING:      "pending",
+	amdgpu.GPUPageStatus_GPU_PAGE_STATUS_UNRESERVABLE: "unreservable",
+}
+
+// badPagesNeeded returns true when the bad pages are exported or checked
+// against a GPUConfig or model profile health threshold
+func (ga *GPUAgentClient) badPagesNeeded() bool {
+	if exportFieldMap[exportermetrics.GPUMetricField_GPU_BAD_PAGES.String()] {
+		return true
+	}
+	if ga.getHealthThreshholds().GetGPU_BAD_PAGES() != 0 {
+		return true
+	}
+	for _, p := range modelProfiles {
+		if p.thresholds.GetGPU_BAD_PAGES() != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getBadPages returns the bad page counts by gpu uuid, refreshed from
+// gpuagent every badPageRefreshInterval. The stream is read without the
+// cache lock, other callers get the last counts meanwhile. A failed refresh
+// keeps the last counts, nil if they were never fetched, and is retried
+// after badPageRetryInterval. Nil is returned without reading the stream
+// when the bad pages are neither exported nor checked
+func (ga *GPUAgentClient) getBadPages() map[string]badPageCounts {
+	ga.Lock()
+	client := ga.debugclient
+	ga.Unlock()
+
+	c := ga.bpCache
+	c.Lock()
+	if !ga.badPagesNeeded() {
+		// fetched again as soon as they are needed
+		c.counts = nil
+		c.nextRefresh = time.Time{}
+		c.Unlock()
+		return nil
+	}
+	if client == nil || c.refreshing || time.Now().Before(c.nextRefresh) {
+		counts := c.counts
+		c.Unlock()
+		selfmetrics.CacheHit(selfmetrics.CacheBadPages)
+		return counts
+	}
+	c.refreshing = true
+	c.Unlock()
+
+	selfmetrics.CacheMiss(selfmetrics.CacheBadPages)
+	counts, err := ga.streamBadPages(client)
+
+	c.Lock()
+	defer c.Unlock()
+	c.refreshing = false
+	if err != nil {
+		logger.Log.Printf("gpuagent bad page get failed, keeping the last counts, retrying in %v, %v",
+			badPageRetryInterval, err)
+		c.nextRefresh = time.Now().Add(badPageRetryInterval)
+		return c.counts
+	}
+	c.counts = counts
+	c.nextRefresh = time.Now().Add(badPageRefreshInterval)
+	return counts
+}
+
+// streamBadPages reads the bad page records of all gpus
+func (ga *GPUAgentClient) streamBadPages(client amdgpu.DebugGPUSvcClient) (counts map[string]badPageCounts, err error) {
+	start := time.Now()
+	defer func() {
+		selfmetrics.ObserveAgentRPC(amdgpu.DebugGPUSvc_GPUBadPageGet_FullMethodName, start, err)
+	}()
+	ctx, cancel := context.WithTimeout(ga.ctx, queryTimeout)
+	defer cancel()
+
+	stream, err := client.GPUBadPageGet(ctx, &amdgpu.GPUBadPageGetRequest{})
+	if err != nil {
+		return nil, err
+	}
+	counts = make(map[string]badPageCounts)
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return counts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if resp.ApiStatus != amdgpu.ApiStatus_API_STATUS_OK {
+			return nil, fmt.Errorf("%v, error code %v", resp.ApiStatus, resp.ErrorCode)
+		}
+		for _, record := range resp.Record {
+			id, err := uuid.FromBytes(record.GPU)
+			if err != nil {
+				continue
+			}
+			gpuuid := id.String()
+			if _, ok := counts[gpuuid]; !ok {
+				counts[gpuuid] = make(badPageCounts)
+			}
+			counts[gpuuid][record.PageStatus]++
+		}
+	}
+}
@@ -0,0 +1,278 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/metricsutil"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// label value separator for the series key, not a valid utf8 byte
+const labelValueSep = "\xff"
+
+// cumulative fields are monotonic device counters which can be exported as
+// counters, all GPU_ECC_* fields are cumulative as well
+var cumulativeFields = map[string]bool{
+	exportermetrics.GPUMetricField_GPU_ENERGY_CONSUMED.String():                                true,
+	exportermetrics.GPUMetricField_PCIE_REPLAY_COUNT.String():                                  true,
+	exportermetrics.GPUMetricField_PCIE_RECOVERY_COUNT.String():                                true,
+	exportermetrics.GPUMetricField_PCIE_REPLAY_ROLLOVER_COUNT.String():                         true,
+	exportermetrics.GPUMetricField_PCIE_NACK_SENT_COUNT.String():                               true,
+	exportermetrics.GPUMetricField_PCIE_NAC_RECEIVED_COUNT.String():                            true,
+	exportermetrics.GPUMetricField_GPU_XGMI_LINK_RX.String():                                   true,
+	exportermetrics.GPUMetricField_GPU_XGMI_LINK_TX.String():                                   true,
+	exportermetrics.GPUMetricField_GPU_VIOLATION_CURRENT_ACCUMULATED_COUNTER.String():          true,
+	exportermetrics.GPUMetricField_GPU_VIOLATION_PROCESSOR_HOT_RESIDENCY_ACCUMULATED.String():  true,
+	exportermetrics.GPUMetricField_GPU_VIOLATION_PPT_RESIDENCY_ACCUMULATED.String():            true,
+	exportermetrics.GPUMetricField_GPU_VIOLATION_SOCKET_THERMAL_RESIDENCY_ACCUMULATED.String(): true,
+	exportermetrics.GPUMetricField_GPU_VIOLATION_VR_THERMAL_RESIDENCY_ACCUMULATED.String():     true,
+	exportermetrics.GPUMetricField_GPU_VIOLATION_HBM_THERMAL_RESIDENCY_ACCUMULATED.String():    true,
+	exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY_ACCUMULATED.String():                       true,
+	exportermetrics.GPUMetricField_GPU_MEMORY_ACTIVITY_ACCUMULATED.String():                    true,
+	exportermetrics.GPUMetricField_GPU_PROCESS_SDMA_USAGE.String():                             true,
+}
+
+func isCumulativeField(field string) bool {
+	return cumulativeFields[field] || strings.HasPrefix(field, "GPU_ECC_")
+}
+
+// counterName returns the exported name of a cumulative field, names that
+// already end in _total are kept
+func counterName(name string) string {
+	if strings.HasSuffix(name, "_total") {
+		return name
+	}
+	return name + "_total"
+}
+
+// gaugeDesc describes a gpu field exported as a gauge, values are recorded
+// into a metricSet on every update instead of a shared GaugeVec
+type gaugeDesc struct {
+	name    string
+	help    string
+	desc    *prometheus.Desc
+	counter *prometheus.Desc // set when exported as a counter
+	noGauge bool             // counter only, legacy gauge is not exported
+	labels  []string
+	enabled bool   // set when the field is enabled in exportFieldMap
+	field   string // GPUMetricField name, set with enabled
+}
+
+func newGaugeDesc(opts prometheus.GaugeOpts, labels []string) *gaugeDesc {
+	name := prometheus.BuildFQName(opts.Namespace, opts.Subsystem, opts.Name)
+	return &gaugeDesc{
+		name:   name,
+		help:   opts.Help,
+		desc:   prometheus.NewDesc(name, opts.Help, labels, opts.ConstLabels),
+		labels: labels,
+	}
+}
+
+// setMetricType exports the field as a counter as per the metric type mode
+func (g *gaugeDesc) setMetricType(mode string) {
+	switch mode {
+	case metricsutil.MetricTypeCounter, metricsutil.MetricTypeBoth:
+		g.counter = prometheus.NewDesc(counterName(g.name), g.help, g.labels, nil)
+		// a counter keeping the legacy name replaces the gauge in both
+		// modes, the series are the same to queries
+		g.noGauge = mode == metricsutil.MetricTypeCounter || counterName(g.name) == g.name
+	default:
+		g.counter = nil
+		g.noGauge = false
+	}
+}
+
+type seriesKey struct {
+	desc   *prometheus.Desc
+	values string
+}
+
+// counterSeries is the last observed state of a counter series
+type counterSeries struct {
+	value   float64
+	seen    time.Time
+	created time.Time // zero until a reset is observed
+}
+
+// counterTracker keeps counter series across updates to detect resets, a
+// value lower than the last one (gpuagent/driver restart) starts the series
+// over with a created timestamp so rate() doesn't need to guess
+type counterTracker struct {
+	sync.Mutex
+	series map[string]*counterSeries
+}
+
+func newCounterTracker() *counterTracker {
+	return &counterTracker{
+		series: make(map[string]*counterSeries),
+	}
+}
+
+// observe records the value and returns the created time of the series
+func (ct *counterTracker) observe(key string, value float64) time.Time {
+	ct.Lock()
+	defer ct.Unlock()
+	now := time.Now()
+	s, ok := ct.series[key]
+	if !ok {
+		ct.series[key] = &counterSeries{value: value, seen: now}
+		return time.Time{}
+	}
+	if value < s.value {
+		logger.Log.Printf("counter reset detected %v, %v -> %v", key, s.value, value)
+		s.created = s.seen
+	}
+	s.value = value
+	s.seen = now
+	return s.created
+}
+
+// prune drops series which are no longer reported
+func (ct *counterTracker) prune(ms *metricSet) {
+	ct.Lock()
+	defer ct.Unlock()
+	for key := range ct.series {
+		if !ms.counters[key] {
+			delete(ct.series, key)
+		}
+	}
+}
+
+// metricSet holds const metrics built from a single gpuagent response, it
+// is immutable once published through GPUAgentClient.metricsSnapshot
+type metricSet struct {
+	metrics  map[seriesKey]prometheus.Metric
+	counters map[string]bool
+	tracker  *counterTracker
+	fields   map[string]bool // fields recorded if set, see withFields
+}
+
+func newMetricSet(tracker *counterTracker) *metricSet {
+	return &metricSet{
+		metrics:  make(map[seriesKey]prometheus.Metric),
+		counters: make(map[string]bool),
+		tracker:  tracker,
+	}
+}
+
+// withFields returns a view of the set recording only the given fields,
+// the set itself if fields is nil
+func (ms *metricSet) withFields(fields map[string]bool) *metricSet {
+	if fields == nil {
+		return ms
+	}
+	view := *ms
+	view.fields = fields
+	return &view
+}
+
+// set records the value for the series, labels not part of the descriptor
+// are ignored and missing ones are exported empty, last write wins
+func (ms *metricSet) set(g *gaugeDesc, labels map[string]string, value float64) {
+	if g == nil || !g.enabled {
+		return
+	}
+	if ms.fields != nil && !ms.fields[g.field] {
+		return
+	}
+	values := make([]string, len(g.labels))
+	for i, label := range g.labels {
+		values[i] = labels[label]
+	}
+	lvKey := strings.Join(values, labelValueSep)
+	if !g.noGauge {
+		m, err := prometheus.NewConstMetric(g.desc, prometheus.GaugeValue, value, values...)
+		if err != nil {
+			logger.Log.Printf("invalid metric %v err: %v", g.desc, err)
+			return
+		}
+		ms.metrics[seriesKey{desc: g.desc, values: lvKey}] = m
+	}
+	if g.counter != nil && ms.tracker != nil {
+		key := g.name + labelValueSep + lvKey
+		created := ms.tracker.observe(key, value)
+		ms.counters[key] = true
+		var m prometheus.Metric
+		var err error
+		if created.IsZero() {
+			m, err = prometheus.NewConstMetric(g.counter, prometheus.CounterValue, value, values...)
+		} else {
+			m, err = prometheus.NewConstMetricWithCreatedTimestamp(g.counter, prometheus.CounterValue, value, created, values...)
+		}
+		if err != nil {
+			logger.Log.Printf("invalid metric %v err: %v", g.counter, err)
+			return
+		}
+		ms.metrics[seriesKey{desc: g.counter, values: lvKey}] = m
+	}
+}
+
+// publishMetrics makes the set the one served on collect
+func (ga *GPUAgentClient) publishMetrics(ms *metricSet) {
+	ga.metricsSnapshot.Store(ms)
+	if len(ms.metrics) != 0 {
+		ga.counters.prune(ms)
+	}
+}
+
+// Describe implements prometheus.Collector for all the enabled fields
+func (ga *GPUAgentClient) Describe(ch chan<- *prometheus.Desc) {
+	for _, meta := range fieldMetricsMap {
+		if meta.Metric == nil || !meta.Metric.enabled {
+			continue
+		}
+		if !meta.Metric.noGauge {
+			ch <- meta.Metric.desc
+		}
+		if meta.Metric.counter != nil {
+			ch <- meta.Metric.counter
+		}
+	}
+}
+
+// Collect implements prometheus.Collector, emits the last published snapshot
+func (ga *GPUAgentClient) Collect(ch chan<- prometheus.Metric) {
+	ms := ga.metricsSnapshot.Load()
+	if ms == nil {
+		return
+	}
+	for _, m := range ms.metrics {
+		ch <- m
+	}
+}
+
+// GetFieldMetricNames implements metricsutil.FieldMetricsResolver
+func (ga *GPUAgentClient) GetFieldMetricNames(field string) []string {
+	meta, ok := fieldMetricsMap[field]
+	if !ok || meta.Metric == nil || !meta.Metric.enabled {
+		return nil
+	}
+	names := []string{}
+	if !meta.Metric.noGauge {
+		names = append(names, meta.Metric.name)
+	}
+	if meta.Metric.counter != nil {
+		names = append(names, counterName(meta.Metric.name))
+	}
+	return names
+}
@@ -0,0 +1,227 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+// gpuSample holds the cumulative stats of a gpu the rates are computed from
+type gpuSample struct {
+	at           time.Time
+	index        uint32
+	energy       float64 // uJ
+	eccCorrect   float64
+	eccUncorrect float64
+	pcieReplays  float64
+	xgmiRead     []float64 // KB per link
+	xgmiWrite    []float64
+	derived      *derivedValues // rates from the previous sample
+}
+
+// derivedValues are the rates between the last two samples of a gpu, nil
+// when unknown, e.g. on the first sample or after a counter reset
+type derivedValues struct {
+	power            *float64  // W
+	eccCorrectRate   *float64  // per minute
+	eccUncorrectRate *float64  // per minute
+	pcieReplayRate   *float64  // per minute
+	xgmiUtilization  []float64 // % per link, nil when unknown
+}
+
+// derivedTracker keeps the previous sample of every gpu by uuid, so gpus
+// re-enumerated with another index or removed don't mix their counters
+type derivedTracker struct {
+	sync.Mutex
+	samples map[string]*gpuSample
+}
+
+func newDerivedTracker() *derivedTracker {
+	return &derivedTracker{samples: make(map[string]*gpuSample)}
+}
+
+func gpuKey(gpu *amdgpu.GPU) string {
+	id, err := uuid.FromBytes(gpu.Spec.Id)
+	if err != nil {
+		return fmt.Sprintf("%x", gpu.Spec.Id)
+	}
+	return id.String()
+}
+
+// newGPUSample reads the cumulative stats of the gpu
+func newGPUSample(gpu *amdgpu.GPU, at time.Time) *gpuSample {
+	stats := gpu.Stats
+	s := &gpuSample{
+		at:           at,
+		index:        gpu.Status.Index,
+		energy:       utils.NormalizeFloat(stats.EnergyConsumed),
+		eccCorrect:   utils.NormalizeUint64(stats.TotalCorrectableErrors),
+		eccUncorrect: utils.NormalizeUint64(stats.TotalUncorrectableErrors),
+	}
+	if stats.PCIeStats != nil {
+		s.pcieReplays = utils.NormalizeUint64(stats.PCIeStats.ReplayCount)
+	}
+	for _, link := range stats.XGMILinkStats {
+		s.xgmiRead = append(s.xgmiRead, utils.NormalizeUint64(link.DataRead))
+		s.xgmiWrite = append(s.xgmiWrite, utils.NormalizeUint64(link.DataWrite))
+	}
+	return s
+}
+
+// rate returns the increase per second of a counter, nil on a reset
+func rate(prev, cur float64, elapsed float64) *float64 {
+	if cur < prev {
+		return nil
+	}
+	r := (cur - prev) / elapsed
+	return &r
+}
+
+// perMinute scales a per second rate
+func perMinute(r *float64) *float64 {
+	if r == nil {
+		return nil
+	}
+	m := *r * 60
+	return &m
+}
+
+// derive computes the rates from prev to cur
+func derive(prev, cur *gpuSample, gpu *amdgpu.GPU) *derivedValues {
+	elapsed := cur.at.Sub(prev.at).Seconds()
+	d := &derivedValues{}
+	if power := rate(prev.energy, cur.energy, elapsed); power != nil {
+		// uJ/s
+		w := *power / 1e6
+		d.power = &w
+	}
+	d.eccCorrectRate = perMinute(rate(prev.eccCorrect, cur.eccCorrect, elapsed))
+	d.eccUncorrectRate = perMinute(rate(prev.eccUncorrect, cur.eccUncorrect, elapsed))
+	d.pcieReplayRate = perMinute(rate(prev.pcieReplays, cur.pcieReplays, elapsed))
+
+	// a link is full duplex, its utilization is the one of the busier
+	// direction against the link speed
+	var speed float64
+	if gpu.Status.XGMIStatus != nil {
+		speed = utils.NormalizeUint64(gpu.Status.XGMIStatus.Speed)
+	}
+	if speed != 0 && len(prev.xgmiRead) == len(cur.xgmiRead) {
+		for i := range cur.xgmiRead {
+			read := rate(prev.xgmiRead[i], cur.xgmiRead[i], elapsed)
+			write := rate(prev.xgmiWrite[i], cur.xgmiWrite[i], elapsed)
+			if read == nil || write == nil {
+				d.xgmiUtilization = nil
+				break
+			}
+			// KB/s against GB/s
+			d.xgmiUtilization = append(d.xgmiUtilization, max(*read, *write)/(speed*1e6)*100)
+		}
+	}
+	return d
+}
+
+// observe records the gpus read at the given time and computes their rates
+// from the previous samples, gpus missing from the read are dropped
+func (dt *derivedTracker) observe(gpus []*amdgpu.GPU, at time.Time) {
+	dt.Lock()
+	defer dt.Unlock()
+	seen := make(map[string]bool)
+	for _, gpu := range gpus {
+		if gpu.Spec == nil || gpu.Status == nil || gpu.Stats == nil {
+			continue
+		}
+		key := gpuKey(gpu)
+		seen[key] = true
+		prev, ok := dt.samples[key]
+		if ok && !at.After(prev.at) {
+			// same cached read, keep the rates
+			continue
+		}
+		cur := newGPUSample(gpu, at)
+		// a gpu enumerated with another index restarts from a new sample
+		if ok && prev.index == cur.index {
+			cur.derived = derive(prev, cur, gpu)
+		}
+		dt.samples[key] = cur
+	}
+	for key := range dt.samples {
+		if !seen[key] {
+			delete(dt.samples, key)
+		}
+	}
+}
+
+// get returns the rates of the gpu, nil if unknown
+func (dt *derivedTracker) get(gpu *amdgpu.GPU) *derivedValues {
+	dt.Lock()
+	defer dt.Unlock()
+	if s, ok := dt.samples[gpuKey(gpu)]; ok {
+		return s.derived
+	}
+	return nil
+}
+
+// pcieUtilization returns the pcie bandwidth against the max speed and
+// width line rate in percent, without the encoding overhead
+func pcieUtilization(status *amdgpu.GPUPCIeStatus) (float64, bool) {
+	if status == nil {
+		return 0, false
+	}
+	bandwidth := utils.NormalizeUint64(status.Bandwidth)
+	// GT/s per lane to MB/s
+	maxBandwidth := utils.NormalizeUint64(status.MaxSpeed) * utils.NormalizeUint64(status.MaxWidth) * 1000 / 8
+	if maxBandwidth == 0 {
+		return 0, false
+	}
+	return bandwidth / maxBandwidth * 100, true
+}
+
+// updateDerivedMetrics exports the rates of the gpu
+func (ga *GPUAgentClient) updateDerivedMetrics(ms *metricSet, labels, labelsWithIndex map[string]string, gpu *amdgpu.GPU) {
+	if util, ok := pcieUtilization(gpu.Status.PCIeStatus); ok {
+		ms.set(ga.m.gpuDerivedPCIeUtilization, labels, util)
+	}
+	d := ga.derived.get(gpu)
+	if d == nil {
+		return
+	}
+	for _, v := range []struct {
+		g     *gaugeDesc
+		value *float64
+	}{
+		{ga.m.gpuDerivedPower, d.power},
+		{ga.m.gpuDerivedEccCorrectRate, d.eccCorrectRate},
+		{ga.m.gpuDerivedEccUncorrectRate, d.eccUncorrectRate},
+		{ga.m.gpuDerivedPCIeReplayRate, d.pcieReplayRate},
+	} {
+		if v.value != nil {
+			ms.set(v.g, labels, *v.value)
+		}
+	}
+	for i, util := range d.xgmiUtilization {
+		labelsWithIndex["link_index"] = fmt.Sprintf("%v", i)
+		ms.set(ga.m.gpuDerivedXgmiUtilization, labelsWithIndex, util)
+	}
+	delete(labelsWithIndex, "link_index")
+}
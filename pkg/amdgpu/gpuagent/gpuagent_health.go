@@ -18,6 +18,7 @@ package gpuagent
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,8 +29,11 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
 	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
+const computeNodeUnhealthy = "compute node marked unhealthy"
+
 func (ga *GPUAgentClient) getHealthThreshholds() *exportermetrics.GPUHealthThresholds {
 	rConfig := ga.mh.GetRunConfig()
 	// config is never nil as the handler preserves default config
@@ -57,18 +61,30 @@ func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[str
 		if count > float64(threshold) {
 			// set health to unhealthy
 			gpuHealthMap[gpuid].Health = strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+			// the reason is kept stable as the count grows, the count is
+			// reported on its own
+			gpuHealthMap[gpuid].HealthReasons = append(gpuHealthMap[gpuid].HealthReasons,
+				fmt.Sprintf("%v crossed threshold %v", fieldName, threshold))
+			if gpuHealthMap[gpuid].ErrorCounts == nil {
+				gpuHealthMap[gpuid].ErrorCounts = make(map[string]uint64)
+			}
+			gpuHealthMap[gpuid].ErrorCounts[fieldName] = uint64(count)
 			logger.Log.Printf("gpuid[%v] is set to unhealthy for ecc field [%v] error crossing threshold %v, current value %v", gpuid, fieldName, threshold, count)
 		}
 	}
 	// this will fetch the latest threshold as the config refresh is done
 	// through metrics handler in the main thread
-	thresholds := ga.getHealthThreshholds()
+	defaultThresholds := ga.getHealthThreshholds()
+	// refreshed by the health poll every badPageRefreshInterval
+	badPages := ga.getBadPages()
 
 	for _, gpu := range gpus {
 		uuid, _ := uuid.FromBytes(gpu.Spec.Id)
 		gpuid := fmt.Sprintf("%v", gpu.Status.Index)
 		gpuuid := uuid.String()
 		stats := gpu.Stats
+		// gpu model profile thresholds take precedence
+		thresholds := ga.gpuModelProfile(gpu).healthThresholds(defaultThresholds)
 		deviceid := ""
 		if gpu.Status.PCIeStatus != nil {
 			deviceid = strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId)
@@ -115,6 +131,11 @@ func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[str
 		metricErrCheck(gpuid, "GPU_ECC_UNCORRECT_JPEG", thresholds.GPU_ECC_UNCORRECT_JPEG, utils.NormalizeUint64(stats.JPEGUncorrectableErrors))
 		metricErrCheck(gpuid, "GPU_ECC_UNCORRECT_IH", thresholds.GPU_ECC_UNCORRECT_IH, utils.NormalizeUint64(stats.IHUncorrectableErrors))
 		metricErrCheck(gpuid, "GPU_ECC_UNCORRECT_MPIO", thresholds.GPU_ECC_UNCORRECT_MPIO, utils.NormalizeUint64(stats.MPIOUncorrectableErrors))
+		// unlike the ecc thresholds 0 disables the bad page check, pages
+		// of all states are counted
+		if thresholds.GPU_BAD_PAGES != 0 {
+			metricErrCheck(gpuid, "GPU_BAD_PAGES", thresholds.GPU_BAD_PAGES, float64(badPages[gpuuid].total()))
+		}
 	}
 
 	return gpuHealthMap
@@ -125,11 +146,11 @@ func (ga *GPUAgentClient) processEccErrorMetrics(gpus []*amdgpu.GPU, wls map[str
 // to make all gpu unavailable through
 // device plugin - populate the old pcie bus entries with updated workload
 // list
-func (ga *GPUAgentClient) setUnhealthyGPU(wls map[string]scheduler.Workload) error {
+func (ga *GPUAgentClient) setUnhealthyGPU(wls map[string]scheduler.Workload, reason string) error {
 	// valid only for k8s case
 	ga.Lock()
-	defer ga.Unlock()
-
+	old := ga.cloneHealthState()
+	defer ga.unlockAndNotify(old)
 	for _, gpustate := range ga.healthState {
 		workloadInfo := []string{} // one per gpu
 		if wl, ok := wls[gpustate.Device]; ok {
@@ -142,6 +163,7 @@ func (ga *GPUAgentClient) setUnhealthyGPU(wls map[string]scheduler.Workload) err
 
 		}
 		gpustate.Health = strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+		gpustate.HealthReasons = []string{reason}
 		gpustate.AssociatedWorkload = workloadInfo
 	}
 
@@ -150,14 +172,76 @@ func (ga *GPUAgentClient) setUnhealthyGPU(wls map[string]scheduler.Workload) err
 
 func (ga *GPUAgentClient) updateNewHealthState(newGPUState map[string]*metricssvc.GPUState) error {
 	ga.Lock()
-	defer ga.Unlock()
+	old := ga.healthState
 	ga.healthState = make(map[string]*metricssvc.GPUState)
 	for gpuid, hstate := range newGPUState {
 		ga.healthState[gpuid] = hstate
 	}
+	ga.unlockAndNotify(old)
 	return nil
 }
 
+// SetHealthWatch sets the callback receiving the GPU health state changes
+// and the ids of the GPUs no longer reported
+func (ga *GPUAgentClient) SetHealthWatch(notify func(states []*metricssvc.GPUState, removed []string)) {
+	ga.Lock()
+	defer ga.Unlock()
+	ga.healthWatch = notify
+}
+
+// cloneHealthState returns a copy of the health states, caller holds the
+// lock
+func (ga *GPUAgentClient) cloneHealthState() map[string]*metricssvc.GPUState {
+	states := make(map[string]*metricssvc.GPUState, len(ga.healthState))
+	for gpuid, hstate := range ga.healthState {
+		states[gpuid] = proto.Clone(hstate).(*metricssvc.GPUState)
+	}
+	return states
+}
+
+// healthChanged returns true if the states differ other than by the error
+// counts, which would otherwise be sent on every new error
+func healthChanged(prev, cur *metricssvc.GPUState) bool {
+	p := proto.Clone(prev).(*metricssvc.GPUState)
+	c := proto.Clone(cur).(*metricssvc.GPUState)
+	p.ErrorCounts, c.ErrorCounts = nil, nil
+	return !proto.Equal(p, c)
+}
+
+// unlockAndNotify releases the lock held by the caller and sends the
+// states which differ from old and the ids missing from the new states to
+// the health watch. The watch is called outside of the lock, notifyMu is
+// taken before unlocking so the changes are still sent in order
+func (ga *GPUAgentClient) unlockAndNotify(old map[string]*metricssvc.GPUState) {
+	notify := ga.healthWatch
+	if notify == nil {
+		ga.Unlock()
+		return
+	}
+	changed := []*metricssvc.GPUState{}
+	for gpuid, hstate := range ga.healthState {
+		if prev, ok := old[gpuid]; !ok || healthChanged(prev, hstate) {
+			changed = append(changed, proto.Clone(hstate).(*metricssvc.GPUState))
+		}
+	}
+	removed := []string{}
+	for gpuid := range old {
+		if _, ok := ga.healthState[gpuid]; !ok {
+			removed = append(removed, gpuid)
+		}
+	}
+	if len(changed) == 0 && len(removed) == 0 {
+		ga.Unlock()
+		return
+	}
+	ga.notifyMu.Lock()
+	defer ga.notifyMu.Unlock()
+	ga.Unlock()
+	sort.Slice(changed, func(i, j int) bool { return changed[i].ID < changed[j].ID })
+	sort.Strings(removed)
+	notify(changed, removed)
+}
+
 func (ga *GPUAgentClient) processHealthValidation() error {
 	wls, err := ga.ListWorkloads()
 	if err != nil {
@@ -167,7 +251,7 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 	ga.Lock()
 	if !ga.computeNodeHealthState { // unhealthy
 		ga.Unlock()
-		_ = ga.setUnhealthyGPU(wls)
+		_ = ga.setUnhealthyGPU(wls, computeNodeUnhealthy)
 		err := fmt.Errorf("compute node unhealthy, cannot process metrics")
 		logger.Log.Printf("err: %+v", err)
 		return err
@@ -191,6 +275,8 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 		if e.Severity == amdgpu.EventSeverity_EVENT_SEVERITY_CRITICAL {
 			if gpuid, ok := gpuUUIDMap[gpuuid]; ok {
 				newGPUState[gpuid].Health = strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String())
+				newGPUState[gpuid].HealthReasons = append(newGPUState[gpuid].HealthReasons,
+					fmt.Sprintf("critical event %v at %v: %v", e.Id, ts, e.Description))
 				logger.Log.Printf("gpuid[%v] is set to unhealthy for evt[%+v]", gpuid, e)
 			} else {
 				logger.Log.Printf("ignoring invalid gpuid[%v] is set to unhealthy for evt[%+v]", gpuuid, e)
@@ -206,7 +292,7 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 	} else if len(gpumetrics.Response) == 0 {
 		// on driver crash gpuagent will return 0 gpus, handle such cases
 		// if we have old state, mark all of the gpu as unhealthy
-		return ga.setUnhealthyGPU(wls)
+		return ga.setUnhealthyGPU(wls, "gpuagent reported no GPUs")
 	} else {
 		newGPUState = ga.processEccErrorMetrics(gpumetrics.Response, wls)
 	}
@@ -234,7 +320,7 @@ ret:
 	if errOccured {
 		ga.Close()
 		// set state to unhealthy with updated workload list
-		_ = ga.setUnhealthyGPU(wls)
+		_ = ga.setUnhealthyGPU(wls, "gpuagent data pull failed")
 		return fmt.Errorf("data pull error occured")
 	}
 
@@ -280,7 +366,7 @@ func (ga *GPUAgentClient) GetGPUHealthStates() (map[string]interface{}, error) {
 		return nil, fmt.Errorf("health status not available")
 	}
 	healthMap := make(map[string]interface{})
-	for id, gstate := range ga.healthState {
+	for id, gstate := range ga.cloneHealthState() {
 		healthMap[id] = gstate
 	}
 
@@ -302,21 +388,26 @@ func (ga *GPUAgentClient) SetComputeNodeHealthState(state bool) {
 	ga.Unlock()
 
 	if !state { // Mark GPUs as unavailable only if the state is unhealthy (false).
-		ga.updateAllGPUsHealthState(strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String()))
+		ga.updateAllGPUsHealthState(strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String()), []string{computeNodeUnhealthy})
 	} else {
-		ga.updateAllGPUsHealthState(strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()))
+		ga.updateAllGPUsHealthState(strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()), nil)
 	}
 }
 
-func (ga *GPUAgentClient) updateAllGPUsHealthState(healthStr string) {
+func (ga *GPUAgentClient) updateAllGPUsHealthState(healthStr string, reasons []string) {
+	ga.Lock()
 	// If health state is already set, mark all GPUs as unhealthy
 	if len(ga.healthState) > 0 {
 		logger.Log.Printf("GPUs are already fetched, setting health state")
+		old := ga.cloneHealthState()
 		for gpuid := range ga.healthState {
 			ga.healthState[gpuid].Health = healthStr
+			ga.healthState[gpuid].HealthReasons = reasons
 		}
+		ga.unlockAndNotify(old)
 		return
 	}
+	ga.Unlock()
 
 	logger.Log.Printf("fetch GPUs and set health state")
 	// If health state is not set, fetch GPUs and mark them as unhealthy
@@ -327,6 +418,9 @@ func (ga *GPUAgentClient) updateAllGPUsHealthState(healthStr string) {
 		return
 	}
 
+	ga.Lock()
+	old := ga.cloneHealthState()
+	defer ga.unlockAndNotify(old)
 	for _, gpu := range gpus.Response {
 		uuid, _ := uuid.FromBytes(gpu.Spec.Id)
 		gpuid := fmt.Sprintf("%v", gpu.Status.Index)
@@ -352,6 +446,7 @@ func (ga *GPUAgentClient) updateAllGPUsHealthState(healthStr string) {
 			ID:                 gpuid,
 			UUID:               gpuuid,
 			Health:             healthStr,
+			HealthReasons:      reasons,
 			Device:             deviceid,
 			AssociatedWorkload: workloadInfo,
 		}
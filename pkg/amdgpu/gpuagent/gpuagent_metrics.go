@@ -18,6 +18,7 @@ package gpuagent
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
@@ -34,7 +35,7 @@ import (
 )
 
 type FieldMeta struct {
-	Metric prometheus.GaugeVec
+	Metric *gaugeDesc
 	Alias  string
 }
 
@@ -68,6 +69,38 @@ var (
 	k8PodLabelsMap    map[string]map[string]string
 )
 
+// exported values of the gpuagent status enums
+var (
+	throttlingStatusValues = map[amdgpu.GPUThrottlingStatus]float64{
+		amdgpu.GPUThrottlingStatus_GPU_THROTTLING_STATUS_OFF: 0,
+		amdgpu.GPUThrottlingStatus_GPU_THROTTLING_STATUS_ON:  1,
+	}
+	operStatusValues = map[amdgpu.GPUOperStatus]float64{
+		amdgpu.GPUOperStatus_GPU_OPER_STATUS_DOWN: 0,
+		amdgpu.GPUOperStatus_GPU_OPER_STATUS_UP:   1,
+	}
+	xgmiErrorStatusValues = map[amdgpu.GPUXGMIErrorStatus]float64{
+		amdgpu.GPUXGMIErrorStatus_GPU_XGMI_STATUS_NO_ERROR:       0,
+		amdgpu.GPUXGMIErrorStatus_GPU_XGMI_STATUS_ONE_ERROR:      1,
+		amdgpu.GPUXGMIErrorStatus_GPU_XGMI_STATUS_MULTIPLE_ERROR: 2,
+	}
+	// gpu_hardware_info labels
+	hardwareInfoLabels = []string{
+		"card_sku",
+		"vbios_part_number",
+		"vbios_version",
+		"vram_type",
+		"vram_vendor",
+		"driver_version",
+	}
+	pcieSlotTypes = map[amdgpu.PCIeSlotType]string{
+		amdgpu.PCIeSlotType_PCIE_SLOT_TYPE_PCIE:    "pcie",
+		amdgpu.PCIeSlotType_PCIE_SLOT_TYPE_OAM:     "oam",
+		amdgpu.PCIeSlotType_PCIE_SLOT_TYPE_CEM:     "cem",
+		amdgpu.PCIeSlotType_PCIE_SLOT_TYPE_UNKNOWN: "unknown",
+	}
+)
+
 const (
 	// starting and ending should align with Profiler Metrics block of enums
 	// from exporterconfig.proto
@@ -76,180 +109,210 @@ const (
 )
 
 type metrics struct {
-	gpuNodesTotal              prometheus.GaugeVec
-	gpuPackagePower            prometheus.GaugeVec
-	gpuAvgPkgPower             prometheus.GaugeVec
-	gpuEdgeTemp                prometheus.GaugeVec
-	gpuJunctionTemp            prometheus.GaugeVec
-	gpuMemoryTemp              prometheus.GaugeVec
-	gpuHBMTemp                 prometheus.GaugeVec
-	gpuGFXActivity             prometheus.GaugeVec
-	gpuUMCActivity             prometheus.GaugeVec
-	gpuMMAActivity             prometheus.GaugeVec
-	gpuVCNActivity             prometheus.GaugeVec
-	gpuJPEGActivity            prometheus.GaugeVec
-	gpuVoltage                 prometheus.GaugeVec
-	gpuGFXVoltage              prometheus.GaugeVec
-	gpuMemVoltage              prometheus.GaugeVec
-	gpuPCIeSpeed               prometheus.GaugeVec
-	gpuPCIeMaxSpeed            prometheus.GaugeVec
-	gpuPCIeBandwidth           prometheus.GaugeVec
-	gpuEnergyConsumed          prometheus.GaugeVec
-	gpuPCIeReplayCount         prometheus.GaugeVec
-	gpuPCIeRecoveryCount       prometheus.GaugeVec
-	gpuPCIeReplayRolloverCount prometheus.GaugeVec
-	gpuPCIeNACKSentCount       prometheus.GaugeVec
-	gpuPCIeNACKReceivedCount   prometheus.GaugeVec
-	gpuClock                   prometheus.GaugeVec
-	gpuPowerUsage              prometheus.GaugeVec
-
-	gpuEccCorrectTotal      prometheus.GaugeVec
-	gpuEccUncorrectTotal    prometheus.GaugeVec
-	gpuEccCorrectSDMA       prometheus.GaugeVec
-	gpuEccUncorrectSDMA     prometheus.GaugeVec
-	gpuEccCorrectGFX        prometheus.GaugeVec
-	gpuEccUncorrectGFX      prometheus.GaugeVec
-	gpuEccCorrectMMHUB      prometheus.GaugeVec
-	gpuEccUncorrectMMHUB    prometheus.GaugeVec
-	gpuEccCorrectATHUB      prometheus.GaugeVec
-	gpuEccUncorrectATHUB    prometheus.GaugeVec
-	gpuEccCorrectBIF        prometheus.GaugeVec
-	gpuEccUncorrectBIF      prometheus.GaugeVec
-	gpuEccCorrectHDP        prometheus.GaugeVec
-	gpuEccUncorrectHDP      prometheus.GaugeVec
-	gpuEccCorrectXgmiWAFL   prometheus.GaugeVec
-	gpuEccUncorrectXgmiWAFL prometheus.GaugeVec
-	gpuEccCorrectDF         prometheus.GaugeVec
-	gpuEccUncorrectDF       prometheus.GaugeVec
-	gpuEccCorrectSMN        prometheus.GaugeVec
-	gpuEccUncorrectSMN      prometheus.GaugeVec
-	gpuEccCorrectSEM        prometheus.GaugeVec
-	gpuEccUncorrectSEM      prometheus.GaugeVec
-	gpuEccCorrectMP0        prometheus.GaugeVec
-	gpuEccUncorrectMP0      prometheus.GaugeVec
-	gpuEccCorrectMP1        prometheus.GaugeVec
-	gpuEccUncorrectMP1      prometheus.GaugeVec
-	gpuEccCorrectFUSE       prometheus.GaugeVec
-	gpuEccUncorrectFUSE     prometheus.GaugeVec
-	gpuEccCorrectUMC        prometheus.GaugeVec
-	gpuEccUncorrectUMC      prometheus.GaugeVec
-	xgmiNbrNopTx0           prometheus.GaugeVec
-	xgmiNbrReqTx0           prometheus.GaugeVec
-	xgmiNbrRespTx0          prometheus.GaugeVec
-	xgmiNbrBeatsTx0         prometheus.GaugeVec
-	xgmiNbrNopTx1           prometheus.GaugeVec
-	xgmiNbrReqTx1           prometheus.GaugeVec
-	xgmiNbrRespTx1          prometheus.GaugeVec
-	xgmiNbrBeatsTx1         prometheus.GaugeVec
-	xgmiNbrTxTput0          prometheus.GaugeVec
-	xgmiNbrTxTput1          prometheus.GaugeVec
-	xgmiNbrTxTput2          prometheus.GaugeVec
-	xgmiNbrTxTput3          prometheus.GaugeVec
-	xgmiNbrTxTput4          prometheus.GaugeVec
-	xgmiNbrTxTput5          prometheus.GaugeVec
-
-	gpuTotalVram prometheus.GaugeVec
-	gpuUsedVram  prometheus.GaugeVec
-	gpuFreeVram  prometheus.GaugeVec
-
-	gpuTotalVisibleVram prometheus.GaugeVec
-	gpuUsedVisibleVram  prometheus.GaugeVec
-	gpuFreeVisibleVram  prometheus.GaugeVec
-
-	gpuTotalGTT prometheus.GaugeVec
-	gpuUsedGTT  prometheus.GaugeVec
-	gpuFreeGTT  prometheus.GaugeVec
-
-	gpuEccCorrectMCA   prometheus.GaugeVec
-	gpuEccUncorrectMCA prometheus.GaugeVec
-
-	gpuEccCorrectVCN   prometheus.GaugeVec
-	gpuEccUncorrectVCN prometheus.GaugeVec
-
-	gpuEccCorrectJPEG   prometheus.GaugeVec
-	gpuEccUncorrectJPEG prometheus.GaugeVec
-
-	gpuEccCorrectIH   prometheus.GaugeVec
-	gpuEccUncorrectIH prometheus.GaugeVec
-
-	gpuEccCorrectMPIO   prometheus.GaugeVec
-	gpuEccUncorrectMPIO prometheus.GaugeVec
-
-	gpuHealth prometheus.GaugeVec
-
-	gpuXgmiLinkStatsRx prometheus.GaugeVec
-	gpuXgmiLinkStatsTx prometheus.GaugeVec
-
-	gpuCurrAccCtr prometheus.GaugeVec
-	gpuProcHRA    prometheus.GaugeVec
-	gpuPPTRA      prometheus.GaugeVec
-	gpuSTRA       prometheus.GaugeVec
-	gpuVRTRA      prometheus.GaugeVec
-	gpuHBMTRA     prometheus.GaugeVec
-
-	gpuGfxBusyInst  prometheus.GaugeVec
-	gpuVcnBusyInst  prometheus.GaugeVec
-	gpuJpegBusyInst prometheus.GaugeVec
+	gpuNodesTotal              *gaugeDesc
+	gpuPackagePower            *gaugeDesc
+	gpuAvgPkgPower             *gaugeDesc
+	gpuEdgeTemp                *gaugeDesc
+	gpuJunctionTemp            *gaugeDesc
+	gpuMemoryTemp              *gaugeDesc
+	gpuHBMTemp                 *gaugeDesc
+	gpuGFXActivity             *gaugeDesc
+	gpuUMCActivity             *gaugeDesc
+	gpuMMAActivity             *gaugeDesc
+	gpuVCNActivity             *gaugeDesc
+	gpuJPEGActivity            *gaugeDesc
+	gpuVoltage                 *gaugeDesc
+	gpuGFXVoltage              *gaugeDesc
+	gpuMemVoltage              *gaugeDesc
+	gpuPCIeSpeed               *gaugeDesc
+	gpuPCIeMaxSpeed            *gaugeDesc
+	gpuPCIeBandwidth           *gaugeDesc
+	gpuEnergyConsumed          *gaugeDesc
+	gpuPCIeReplayCount         *gaugeDesc
+	gpuPCIeRecoveryCount       *gaugeDesc
+	gpuPCIeReplayRolloverCount *gaugeDesc
+	gpuPCIeNACKSentCount       *gaugeDesc
+	gpuPCIeNACKReceivedCount   *gaugeDesc
+	gpuClock                   *gaugeDesc
+	gpuPowerUsage              *gaugeDesc
+
+	gpuEccCorrectTotal      *gaugeDesc
+	gpuEccUncorrectTotal    *gaugeDesc
+	gpuEccCorrectSDMA       *gaugeDesc
+	gpuEccUncorrectSDMA     *gaugeDesc
+	gpuEccCorrectGFX        *gaugeDesc
+	gpuEccUncorrectGFX      *gaugeDesc
+	gpuEccCorrectMMHUB      *gaugeDesc
+	gpuEccUncorrectMMHUB    *gaugeDesc
+	gpuEccCorrectATHUB      *gaugeDesc
+	gpuEccUncorrectATHUB    *gaugeDesc
+	gpuEccCorrectBIF        *gaugeDesc
+	gpuEccUncorrectBIF      *gaugeDesc
+	gpuEccCorrectHDP        *gaugeDesc
+	gpuEccUncorrectHDP      *gaugeDesc
+	gpuEccCorrectXgmiWAFL   *gaugeDesc
+	gpuEccUncorrectXgmiWAFL *gaugeDesc
+	gpuEccCorrectDF         *gaugeDesc
+	gpuEccUncorrectDF       *gaugeDesc
+	gpuEccCorrectSMN        *gaugeDesc
+	gpuEccUncorrectSMN      *gaugeDesc
+	gpuEccCorrectSEM        *gaugeDesc
+	gpuEccUncorrectSEM      *gaugeDesc
+	gpuEccCorrectMP0        *gaugeDesc
+	gpuEccUncorrectMP0      *gaugeDesc
+	gpuEccCorrectMP1        *gaugeDesc
+	gpuEccUncorrectMP1      *gaugeDesc
+	gpuEccCorrectFUSE       *gaugeDesc
+	gpuEccUncorrectFUSE     *gaugeDesc
+	gpuEccCorrectUMC        *gaugeDesc
+	gpuEccUncorrectUMC      *gaugeDesc
+	xgmiNbrNopTx0           *gaugeDesc
+	xgmiNbrReqTx0           *gaugeDesc
+	xgmiNbrRespTx0          *gaugeDesc
+	xgmiNbrBeatsTx0         *gaugeDesc
+	xgmiNbrNopTx1           *gaugeDesc
+	xgmiNbrReqTx1           *gaugeDesc
+	xgmiNbrRespTx1          *gaugeDesc
+	xgmiNbrBeatsTx1         *gaugeDesc
+	xgmiNbrTxTput0          *gaugeDesc
+	xgmiNbrTxTput1          *gaugeDesc
+	xgmiNbrTxTput2          *gaugeDesc
+	xgmiNbrTxTput3          *gaugeDesc
+	xgmiNbrTxTput4          *gaugeDesc
+	xgmiNbrTxTput5          *gaugeDesc
+
+	gpuTotalVram *gaugeDesc
+	gpuUsedVram  *gaugeDesc
+	gpuFreeVram  *gaugeDesc
+
+	gpuTotalVisibleVram *gaugeDesc
+	gpuUsedVisibleVram  *gaugeDesc
+	gpuFreeVisibleVram  *gaugeDesc
+
+	gpuTotalGTT *gaugeDesc
+	gpuUsedGTT  *gaugeDesc
+	gpuFreeGTT  *gaugeDesc
+
+	gpuEccCorrectMCA   *gaugeDesc
+	gpuEccUncorrectMCA *gaugeDesc
+
+	gpuEccCorrectVCN   *gaugeDesc
+	gpuEccUncorrectVCN *gaugeDesc
+
+	gpuEccCorrectJPEG   *gaugeDesc
+	gpuEccUncorrectJPEG *gaugeDesc
+
+	gpuEccCorrectIH   *gaugeDesc
+	gpuEccUncorrectIH *gaugeDesc
+
+	gpuEccCorrectMPIO   *gaugeDesc
+	gpuEccUncorrectMPIO *gaugeDesc
+
+	gpuHealth *gaugeDesc
+
+	gpuXgmiLinkStatsRx *gaugeDesc
+	gpuXgmiLinkStatsTx *gaugeDesc
+
+	gpuCurrAccCtr *gaugeDesc
+	gpuProcHRA    *gaugeDesc
+	gpuPPTRA      *gaugeDesc
+	gpuSTRA       *gaugeDesc
+	gpuVRTRA      *gaugeDesc
+	gpuHBMTRA     *gaugeDesc
+
+	gpuGfxBusyInst  *gaugeDesc
+	gpuVcnBusyInst  *gaugeDesc
+	gpuJpegBusyInst *gaugeDesc
+
+	gpuFanSpeed         *gaugeDesc
+	gpuGFXActivityAcc   *gaugeDesc
+	gpuMemActivityAcc   *gaugeDesc
+	gpuThrottlingStatus *gaugeDesc
+	gpuOperStatus       *gaugeDesc
+	gpuXgmiErrorStatus  *gaugeDesc
+	gpuXgmiLinkWidth    *gaugeDesc
+	gpuXgmiLinkSpeed    *gaugeDesc
+	gpuPCIeWidth        *gaugeDesc
+	gpuPCIeMaxWidth     *gaugeDesc
+	gpuPCIeSlotType     *gaugeDesc
+	gpuPCIeVersion      *gaugeDesc
+
+	gpuFirmwareInfo *gaugeDesc
+	gpuHardwareInfo *gaugeDesc
+	// gpuHardwareInfo labels which aren't gpu labels already
+	hardwareInfoLabels []string
+	gpuBadPages        *gaugeDesc
+
+	// kfd process usage
+	gpuProcessUsedVRAM    *gaugeDesc
+	gpuProcessSDMAUsage   *gaugeDesc
+	gpuProcessCUOccupancy *gaugeDesc
+
+	// derived from consecutive samples
+	gpuDerivedPower            *gaugeDesc
+	gpuDerivedPCIeUtilization  *gaugeDesc
+	gpuDerivedXgmiUtilization  *gaugeDesc
+	gpuDerivedEccCorrectRate   *gaugeDesc
+	gpuDerivedEccUncorrectRate *gaugeDesc
+	gpuDerivedPCIeReplayRate   *gaugeDesc
 
 	// profiler metrics
-	gpuGrbmGuiActivity               prometheus.GaugeVec
-	gpuSqWaves                       prometheus.GaugeVec
-	gpuGrbmCount                     prometheus.GaugeVec
-	gpuCpcStatBusy                   prometheus.GaugeVec
-	gpuCpcStatIdle                   prometheus.GaugeVec
-	gpuCpcStatStall                  prometheus.GaugeVec
-	gpuCpcTciuBusy                   prometheus.GaugeVec
-	gpuCpcTciuIdle                   prometheus.GaugeVec
-	gpuCpcUtcl2iuBusy                prometheus.GaugeVec
-	gpuCpcUtcl2iuIdle                prometheus.GaugeVec
-	gpuCpcUtcl2iuStall               prometheus.GaugeVec
-	gpuCpcME1BusyForPacketDecode     prometheus.GaugeVec
-	gpuCpcME1Dc0SpiBusy              prometheus.GaugeVec
-	gpuCpcUtcl1StallOnTranslation    prometheus.GaugeVec
-	gpuCpcAlwaysCount                prometheus.GaugeVec
-	gpuCpcAdcValidChunkNotAvail      prometheus.GaugeVec
-	gpuCpcAdcDispatchAllocDone       prometheus.GaugeVec
-	gpuCpcAdcValidChunkEnd           prometheus.GaugeVec
-	gpuCpcSynFifoFullLevel           prometheus.GaugeVec
-	gpuCpcSynFifoFull                prometheus.GaugeVec
-	gpuCpcGdBusy                     prometheus.GaugeVec
-	gpuCpcTgSend                     prometheus.GaugeVec
-	gpuCpcWalkNextChunk              prometheus.GaugeVec
-	gpuCpcStalledBySe0Spi            prometheus.GaugeVec
-	gpuCpcStalledBySe1Spi            prometheus.GaugeVec
-	gpuCpcStalledBySe2Spi            prometheus.GaugeVec
-	gpuCpcStalledBySe3Spi            prometheus.GaugeVec
-	gpuCpcLteAll                     prometheus.GaugeVec
-	gpuCpcSyncWrreqFifoBusy          prometheus.GaugeVec
-	gpuCpcCaneBusy                   prometheus.GaugeVec
-	gpuCpcCaneStall                  prometheus.GaugeVec
-	gpuCpfCmpUtcl1StallOnTrnsalation prometheus.GaugeVec
-	gpuCpfStatBusy                   prometheus.GaugeVec
-	gpuCpfStatIdle                   prometheus.GaugeVec
-	gpuCpfStatStall                  prometheus.GaugeVec
-	gpuCpfStatTciuBusy               prometheus.GaugeVec
-	gpuCpfStatTciuIdle               prometheus.GaugeVec
-	gpuCpfStatTciuStall              prometheus.GaugeVec
-
-	gpuGPUUtil             prometheus.GaugeVec
-	gpuFetchSize           prometheus.GaugeVec
-	gpuWriteSize           prometheus.GaugeVec
-	gpuTotal16Ops          prometheus.GaugeVec
-	gpuTotal32Ops          prometheus.GaugeVec
-	gpuTotal64Ops          prometheus.GaugeVec
-	gpuOccPercent          prometheus.GaugeVec
-	gpuTensorActivePercent prometheus.GaugeVec
-	gpuValuPipeIssueUtil   prometheus.GaugeVec
-	gpuSMActive            prometheus.GaugeVec
-	gpuOccElapsed          prometheus.GaugeVec
-	gpuOccPerActiveCU      prometheus.GaugeVec
+	gpuGrbmGuiActivity               *gaugeDesc
+	gpuSqWaves                       *gaugeDesc
+	gpuGrbmCount                     *gaugeDesc
+	gpuCpcStatBusy                   *gaugeDesc
+	gpuCpcStatIdle                   *gaugeDesc
+	gpuCpcStatStall                  *gaugeDesc
+	gpuCpcTciuBusy                   *gaugeDesc
+	gpuCpcTciuIdle                   *gaugeDesc
+	gpuCpcUtcl2iuBusy                *gaugeDesc
+	gpuCpcUtcl2iuIdle                *gaugeDesc
+	gpuCpcUtcl2iuStall               *gaugeDesc
+	gpuCpcME1BusyForPacketDecode     *gaugeDesc
+	gpuCpcME1Dc0SpiBusy              *gaugeDesc
+	gpuCpcUtcl1StallOnTranslation    *gaugeDesc
+	gpuCpcAlwaysCount                *gaugeDesc
+	gpuCpcAdcValidChunkNotAvail      *gaugeDesc
+	gpuCpcAdcDispatchAllocDone       *gaugeDesc
+	gpuCpcAdcValidChunkEnd           *gaugeDesc
+	gpuCpcSynFifoFullLevel           *gaugeDesc
+	gpuCpcSynFifoFull                *gaugeDesc
+	gpuCpcGdBusy                     *gaugeDesc
+	gpuCpcTgSend                     *gaugeDesc
+	gpuCpcWalkNextChunk              *gaugeDesc
+	gpuCpcStalledBySe0Spi            *gaugeDesc
+	gpuCpcStalledBySe1Spi            *gaugeDesc
+	gpuCpcStalledBySe2Spi            *gaugeDesc
+	gpuCpcStalledBySe3Spi            *gaugeDesc
+	gpuCpcLteAll                     *gaugeDesc
+	gpuCpcSyncWrreqFifoBusy          *gaugeDesc
+	gpuCpcCaneBusy                   *gaugeDesc
+	gpuCpcCaneStall                  *gaugeDesc
+	gpuCpfCmpUtcl1StallOnTrnsalation *gaugeDesc
+	gpuCpfStatBusy                   *gaugeDesc
+	gpuCpfStatIdle                   *gaugeDesc
+	gpuCpfStatStall                  *gaugeDesc
+	gpuCpfStatTciuBusy               *gaugeDesc
+	gpuCpfStatTciuIdle               *gaugeDesc
+	gpuCpfStatTciuStall              *gaugeDesc
+
+	gpuGPUUtil             *gaugeDesc
+	gpuFetchSize           *gaugeDesc
+	gpuWriteSize           *gaugeDesc
+	gpuTotal16Ops          *gaugeDesc
+	gpuTotal32Ops          *gaugeDesc
+	gpuTotal64Ops          *gaugeDesc
+	gpuOccPercent          *gaugeDesc
+	gpuTensorActivePercent *gaugeDesc
+	gpuValuPipeIssueUtil   *gaugeDesc
+	gpuSMActive            *gaugeDesc
+	gpuOccElapsed          *gaugeDesc
+	gpuOccPerActiveCU      *gaugeDesc
 }
 
 func (ga *GPUAgentClient) ResetMetrics() error {
-	// reset all label based fields
-	for _, prommetric := range fieldMetricsMap {
-		prommetric.Metric.Reset()
-	}
+	// metrics are rebuilt on every update and swapped in as a whole, there is
+	// nothing to reset
 	return nil
 }
 
@@ -429,6 +492,17 @@ func initGPUSelectorConfig(config *exportermetrics.GPUMetricConfig) {
 	}
 }
 
+// optInFields are only exported when listed in the config Fields, they
+// aren't part of the default all fields
+var optInFields = map[string]bool{
+	exportermetrics.GPUMetricField_GPU_DERIVED_POWER.String():              true,
+	exportermetrics.GPUMetricField_GPU_DERIVED_PCIE_UTILIZATION.String():   true,
+	exportermetrics.GPUMetricField_GPU_DERIVED_XGMI_UTILIZATION.String():   true,
+	exportermetrics.GPUMetricField_GPU_DERIVED_ECC_CORRECT_RATE.String():   true,
+	exportermetrics.GPUMetricField_GPU_DERIVED_ECC_UNCORRECT_RATE.String(): true,
+	exportermetrics.GPUMetricField_GPU_DERIVED_PCIE_REPLAY_RATE.String():   true,
+}
+
 func initFieldConfig(config *exportermetrics.GPUMetricConfig) {
 	exportFieldMap = make(map[string]bool)
 	// setup metric fields in map to be monitored
@@ -438,7 +512,7 @@ func initFieldConfig(config *exportermetrics.GPUMetricConfig) {
 		enable_default = false
 	}
 	for _, name := range exportermetrics.GPUMetricField_name {
-		exportFieldMap[name] = enable_default
+		exportFieldMap[name] = enable_default && !optInFields[name]
 	}
 	if config == nil || len(config.GetFields()) == 0 {
 		return
@@ -561,6 +635,30 @@ func (ga *GPUAgentClient) initFieldMetricsMap() {
 		exportermetrics.GPUMetricField_GPU_GFX_BUSY_INSTANTANEOUS.String():                         FieldMeta{Metric: ga.m.gpuGfxBusyInst},
 		exportermetrics.GPUMetricField_GPU_VCN_BUSY_INSTANTANEOUS.String():                         FieldMeta{Metric: ga.m.gpuVcnBusyInst},
 		exportermetrics.GPUMetricField_GPU_JPEG_BUSY_INSTANTANEOUS.String():                        FieldMeta{Metric: ga.m.gpuJpegBusyInst},
+		exportermetrics.GPUMetricField_GPU_FAN_SPEED.String():                                      FieldMeta{Metric: ga.m.gpuFanSpeed},
+		exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY_ACCUMULATED.String():                       FieldMeta{Metric: ga.m.gpuGFXActivityAcc},
+		exportermetrics.GPUMetricField_GPU_MEMORY_ACTIVITY_ACCUMULATED.String():                    FieldMeta{Metric: ga.m.gpuMemActivityAcc},
+		exportermetrics.GPUMetricField_GPU_THROTTLING_STATUS.String():                              FieldMeta{Metric: ga.m.gpuThrottlingStatus},
+		exportermetrics.GPUMetricField_GPU_OPER_STATUS.String():                                    FieldMeta{Metric: ga.m.gpuOperStatus},
+		exportermetrics.GPUMetricField_GPU_XGMI_ERROR_STATUS.String():                              FieldMeta{Metric: ga.m.gpuXgmiErrorStatus},
+		exportermetrics.GPUMetricField_GPU_XGMI_LINK_WIDTH.String():                                FieldMeta{Metric: ga.m.gpuXgmiLinkWidth},
+		exportermetrics.GPUMetricField_GPU_XGMI_LINK_SPEED.String():                                FieldMeta{Metric: ga.m.gpuXgmiLinkSpeed},
+		exportermetrics.GPUMetricField_PCIE_WIDTH.String():                                         FieldMeta{Metric: ga.m.gpuPCIeWidth},
+		exportermetrics.GPUMetricField_PCIE_MAX_WIDTH.String():                                     FieldMeta{Metric: ga.m.gpuPCIeMaxWidth},
+		exportermetrics.GPUMetricField_PCIE_SLOT_TYPE.String():                                     FieldMeta{Metric: ga.m.gpuPCIeSlotType},
+		exportermetrics.GPUMetricField_PCIE_VERSION.String():                                       FieldMeta{Metric: ga.m.gpuPCIeVersion},
+		exportermetrics.GPUMetricField_GPU_FIRMWARE_INFO.String():                                  FieldMeta{Metric: ga.m.gpuFirmwareInfo},
+		exportermetrics.GPUMetricField_GPU_HARDWARE_INFO.String():                                  FieldMeta{Metric: ga.m.gpuHardwareInfo},
+		exportermetrics.GPUMetricField_GPU_BAD_PAGES.String():                                      FieldMeta{Metric: ga.m.gpuBadPages},
+		exportermetrics.GPUMetricField_GPU_PROCESS_USED_VRAM.String():                              FieldMeta{Metric: ga.m.gpuProcessUsedVRAM},
+		exportermetrics.GPUMetricField_GPU_PROCESS_SDMA_USAGE.String():                             FieldMeta{Metric: ga.m.gpuProcessSDMAUsage},
+		exportermetrics.GPUMetricField_GPU_PROCESS_CU_OCCUPANCY.String():                           FieldMeta{Metric: ga.m.gpuProcessCUOccupancy},
+		exportermetrics.GPUMetricField_GPU_DERIVED_POWER.String():                                  FieldMeta{Metric: ga.m.gpuDerivedPower},
+		exportermetrics.GPUMetricField_GPU_DERIVED_PCIE_UTILIZATION.String():                       FieldMeta{Metric: ga.m.gpuDerivedPCIeUtilization},
+		exportermetrics.GPUMetricField_GPU_DERIVED_XGMI_UTILIZATION.String():                       FieldMeta{Metric: ga.m.gpuDerivedXgmiUtilization},
+		exportermetrics.GPUMetricField_GPU_DERIVED_ECC_CORRECT_RATE.String():                       FieldMeta{Metric: ga.m.gpuDerivedEccCorrectRate},
+		exportermetrics.GPUMetricField_GPU_DERIVED_ECC_UNCORRECT_RATE.String():                     FieldMeta{Metric: ga.m.gpuDerivedEccUncorrectRate},
+		exportermetrics.GPUMetricField_GPU_DERIVED_PCIE_REPLAY_RATE.String():                       FieldMeta{Metric: ga.m.gpuDerivedPCIeReplayRate},
 		// profiler entries
 		exportermetrics.GPUMetricField_GPU_PROF_GRBM_GUI_ACTIVE.String():                    FieldMeta{Metric: ga.m.gpuGrbmGuiActivity, Alias: "GRBM_GUI_ACTIVE"},
 		exportermetrics.GPUMetricField_GPU_PROF_SQ_WAVES.String():                           FieldMeta{Metric: ga.m.gpuSqWaves, Alias: "SQ_WAVES"},
@@ -650,758 +748,886 @@ func (ga *GPUAgentClient) initProfilerMetricsField() {
 func (ga *GPUAgentClient) initPrometheusMetrics() {
 	nonGpuLabels := ga.GetExporterNonGPULabels()
 	labels := ga.GetExportLabels()
+	// driver and vbios versions may be exported as gpu labels already
+	hwInfoLabels := []string{}
+	for _, label := range hardwareInfoLabels {
+		if !slices.Contains(labels, label) {
+			hwInfoLabels = append(hwInfoLabels, label)
+		}
+	}
 	ga.m = &metrics{
-		gpuNodesTotal: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		hardwareInfoLabels: hwInfoLabels,
+		gpuNodesTotal: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_nodes_total",
 			Help: "Number of GPUs in the node",
 		},
 			nonGpuLabels),
-		gpuPackagePower: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPackagePower: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_package_power",
 			Help: "Current socket power in Watts",
 		},
 			labels),
-		gpuAvgPkgPower: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuAvgPkgPower: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_average_package_power",
 			Help: "Average socket power in Watts",
 		},
 			labels),
-		gpuEdgeTemp: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEdgeTemp: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_edge_temperature",
 			Help: "Current edge temperature in Celsius",
 		},
 			labels),
-		gpuJunctionTemp: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuJunctionTemp: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_junction_temperature",
 			Help: "Current junction/hotspot temperature in Celsius",
 		},
 			labels),
-		gpuMemoryTemp: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuMemoryTemp: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_memory_temperature",
 			Help: "Current memory temperature in Celsius",
 		},
 			labels),
-		gpuHBMTemp: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuHBMTemp: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_hbm_temperature",
 			Help: "List of current HBM temperatures in Celsius",
 		},
 			append([]string{"hbm_index"}, labels...)),
-		gpuGFXActivity: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuGFXActivity: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_gfx_activity",
 			Help: "Graphics engine usage in Percentage (0-100)",
 		},
 			labels),
-		gpuUMCActivity: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuUMCActivity: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_umc_activity",
 			Help: "Memory engine usage in Percentage (0-100)",
 		},
 			labels),
-		gpuMMAActivity: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuMMAActivity: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_mma_activity",
 			Help: "Average MultiMedia (MM) engine usage in Percentage (0-100)",
 		},
 			labels),
-		gpuVCNActivity: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuVCNActivity: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_vcn_activity",
 			Help: "List of Video Core Next (VCN) encoe/decode usage in percentage",
 		},
 			append([]string{"vcn_index"}, labels...)),
-		gpuJPEGActivity: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuJPEGActivity: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_jpeg_activity",
 			Help: "List of JPEG engine usage in Percentage (0-100)",
 		},
 			append([]string{"jpeg_index"}, labels...)),
-		gpuVoltage: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuVoltage: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_voltage",
 			Help: "Current SoC voltage in mV",
 		},
 			labels),
-		gpuGFXVoltage: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuGFXVoltage: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_gfx_voltage",
 			Help: "Current gfx voltage in mV",
 		},
 			labels),
-		gpuMemVoltage: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuMemVoltage: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_memory_voltage",
 			Help: "Current memory voltage in mV",
 		},
 			labels),
-		gpuPCIeSpeed: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeSpeed: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_speed",
 			Help: "Current PCIe speed in GT/s",
 		},
 			labels),
-		gpuPCIeMaxSpeed: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeMaxSpeed: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_max_speed",
 			Help: "Maximum PCIe speed in GT/s",
 		},
 			labels),
-		gpuPCIeBandwidth: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeBandwidth: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_bandwidth",
 			Help: "Current PCIe bandwidth in Mb/s",
 		},
 			labels),
-		gpuEnergyConsumed: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEnergyConsumed: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_energy_consumed",
 			Help: "Accumulated energy consumed by the GPU in uJ",
 		},
 			labels),
-		gpuPCIeReplayCount: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeReplayCount: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_replay_count",
 			Help: "Total number of PCIe replays",
 		},
 			labels),
-		gpuPCIeRecoveryCount: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeRecoveryCount: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_recovery_count",
 			Help: "Total number of PCIe recoveries",
 		},
 			labels),
-		gpuPCIeReplayRolloverCount: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeReplayRolloverCount: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_replay_rollover_count",
 			Help: "PCIe replay accumulated count",
 		},
 			labels),
-		gpuPCIeNACKSentCount: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeNACKSentCount: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_nack_sent_count",
 			Help: "PCIe NAK sent accumulated count",
 		},
 			labels),
-		gpuPCIeNACKReceivedCount: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPCIeNACKReceivedCount: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "pcie_nack_received_count",
 			Help: "PCIe NAK received accumulated count",
 		},
 			labels),
-		gpuClock: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuClock: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_clock",
 			Help: "List of current GPU clock frequencies in MHz",
 		},
 			append([]string{"clock_index", "clock_type"}, labels...)),
-		gpuPowerUsage: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPowerUsage: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_power_usage",
 			Help: "GPU Power usage in Watts",
 		},
 			labels),
-		gpuTotalVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuTotalVram: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_total_vram",
 			Help: "Total VRAM memory of the GPU (in MB)",
 		},
 			labels),
-		gpuUsedVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuUsedVram: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_used_vram",
 			Help: "Used VRAM memory of the GPU (in MB)",
 		},
 			labels),
-		gpuFreeVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuFreeVram: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_free_vram",
 			Help: "Free VRAM memory of the GPU (in MB)",
 		},
 			labels),
-		gpuTotalVisibleVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuTotalVisibleVram: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_total_visible_vram",
 			Help: "Total visible VRAM memory of the GPU (in MB)",
 		},
 			labels),
-		gpuUsedVisibleVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuUsedVisibleVram: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_used_visible_vram",
 			Help: "Used visible VRAM memory of the GPU (in MB)",
 		},
 			labels),
-		gpuFreeVisibleVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuFreeVisibleVram: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_free_visible_vram",
 			Help: "Free visible VRAM memory of the GPU (in MB)",
 		},
 			labels),
-		gpuTotalGTT: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuTotalGTT: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_total_gtt",
 			Help: "Total graphics translation table memory of the GPU (in MB)",
 		},
 			labels),
-		gpuUsedGTT: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuUsedGTT: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_used_gtt",
 			Help: "Used graphics translation table memory of the GPU (in MB)",
 		},
 			labels),
-		gpuFreeGTT: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuFreeGTT: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_free_gtt",
 			Help: "Free graphics translation table memory of the GPU (in MB)",
 		},
 			labels),
-		gpuEccCorrectTotal: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectTotal: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_total",
 			Help: "Total Correctable error count",
 		},
 			labels),
-		gpuEccUncorrectTotal: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectTotal: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_total",
 			Help: "Total Uncorrectable error count",
 		},
 			labels),
-		gpuEccCorrectSDMA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectSDMA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_sdma",
 			Help: "Correctable error count in SDMA block",
 		},
 			labels),
-		gpuEccUncorrectSDMA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectSDMA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_sdma",
 			Help: "Uncorrectable error count in SDMA block",
 		},
 			labels),
-		gpuEccCorrectGFX: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectGFX: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_gfx",
 			Help: "Correctable error count in GFX block",
 		},
 			labels),
-		gpuEccUncorrectGFX: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectGFX: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_gfx",
 			Help: "Uncorrectable error count in GFX block",
 		},
 			labels),
-		gpuEccCorrectMMHUB: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectMMHUB: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_mmhub",
 			Help: "Correctable error count in MMHUB block",
 		},
 			labels),
-		gpuEccUncorrectMMHUB: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectMMHUB: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_mmhub",
 			Help: "Uncorrectable error count in MMHUB block",
 		},
 			labels),
-		gpuEccCorrectATHUB: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectATHUB: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_athub",
 			Help: "Correctable error count in ATHUB block",
 		},
 			labels),
-		gpuEccUncorrectATHUB: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectATHUB: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_athub",
 			Help: "Uncorrectable error count in ATHUB block",
 		},
 			labels),
-		gpuEccCorrectBIF: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectBIF: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_bif",
 			Help: "Correctable error count in BIF block",
 		},
 			labels),
-		gpuEccUncorrectBIF: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectBIF: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_bif",
 			Help: "Uncorrectable error count in BIF block",
 		},
 			labels),
-		gpuEccCorrectHDP: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectHDP: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_hdp",
 			Help: "Correctable error count in HDP block",
 		},
 			labels),
-		gpuEccUncorrectHDP: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectHDP: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_hdp",
 			Help: "Uncorrectable error count in HDP block",
 		},
 			labels),
-		gpuEccCorrectXgmiWAFL: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectXgmiWAFL: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_xgmi_wafl",
 			Help: "Correctable error count in WAFL block",
 		},
 			labels),
-		gpuEccUncorrectXgmiWAFL: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectXgmiWAFL: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_xgmi_wafl",
 			Help: "Uncorrectable error count in WAFL block",
 		},
 			labels),
-		gpuEccCorrectDF: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectDF: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_df",
 			Help: "Correctable error count in DF block",
 		},
 			labels),
-		gpuEccUncorrectDF: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectDF: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_df",
 			Help: "Uncorrectable error count in DF block",
 		},
 			labels),
-		gpuEccCorrectSMN: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectSMN: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_smn",
 			Help: "Correctable error count in SMN block",
 		},
 			labels),
-		gpuEccUncorrectSMN: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectSMN: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_smn",
 			Help: "Uncorrectable error count in SMN block",
 		},
 			labels),
-		gpuEccCorrectSEM: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectSEM: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_sem",
 			Help: "Correctable error count in SEM block",
 		},
 			labels),
-		gpuEccUncorrectSEM: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectSEM: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_sem",
 			Help: "Uncorrectable error count in SEM block",
 		},
 			labels),
-		gpuEccCorrectMP0: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectMP0: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_mp0",
 			Help: "Correctable error count in MP0 block",
 		},
 			labels),
-		gpuEccUncorrectMP0: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectMP0: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_mp0",
 			Help: "Uncorrectable error count in MP0 block",
 		},
 			labels),
-		gpuEccCorrectMP1: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectMP1: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_mp1",
 			Help: "Correctable error count in MP1 block",
 		},
 			labels),
-		gpuEccUncorrectMP1: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectMP1: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_mp1",
 			Help: "Uncorrectable error count in MP1 block",
 		},
 			labels),
-		gpuEccCorrectFUSE: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectFUSE: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_fuse",
 			Help: "Correctable error count in Fuse block",
 		},
 			labels),
-		gpuEccUncorrectFUSE: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectFUSE: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_fuse",
 			Help: "Uncorrectable error count in Fuse block",
 		},
 			labels),
-		gpuEccCorrectUMC: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectUMC: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_umc",
 			Help: "Correctable error count in UMC block",
 		},
 			labels),
-		gpuEccUncorrectUMC: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectUMC: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_umc",
 			Help: "Uncorrectable error count in UMC block",
 		},
 			labels),
-		xgmiNbrNopTx0: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrNopTx0: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_0_nop_tx",
 			Help: "NOPs sent to neighbor 0",
 		},
 			labels),
-		xgmiNbrNopTx1: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrNopTx1: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_1_nop_tx",
 			Help: "NOPs sent to neighbor 1",
 		},
 			labels),
-		xgmiNbrReqTx0: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrReqTx0: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_0_request_tx",
 			Help: "Outgoing requests to neighbor 0",
 		},
 			labels),
-		xgmiNbrReqTx1: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrReqTx1: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_1_request_tx",
 			Help: "Outgoing requests to neighbor 1",
 		},
 			labels),
-		xgmiNbrRespTx0: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrRespTx0: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_0_response_tx",
 			Help: "Outgoing responses to neighbor 0",
 		},
 			labels),
-		xgmiNbrRespTx1: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrRespTx1: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_1_response_tx",
 			Help: "Outgoing responses to neighbor 1",
 		},
 			labels),
-		xgmiNbrBeatsTx0: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrBeatsTx0: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_0_beats_tx",
 			Help: "Data beats sent to neighbor 0; Each beat represents 32 bytes",
 		},
 			labels),
-		xgmiNbrBeatsTx1: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrBeatsTx1: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_1_beats_tx",
 			Help: "Data beats sent to neighbor 1; Each beat represents 32 bytes",
 		},
 			labels),
-		xgmiNbrTxTput0: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrTxTput0: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_0_tx_throughput",
 			Help: "Represents the number of outbound beats (each representing 32 bytes) on link 0; Throughput = BEATS/time_running * 10^9  bytes/sec",
 		},
 			labels),
-		xgmiNbrTxTput1: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrTxTput1: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_1_tx_throughput",
 			Help: "Represents the number of outbound beats (each representing 32 bytes) on link 1; Throughput = BEATS/time_running * 10^9  bytes/sec",
 		},
 			labels),
-		xgmiNbrTxTput2: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrTxTput2: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_2_tx_throughput",
 			Help: "Represents the number of outbound beats (each representing 32 bytes) on link 2; Throughput = BEATS/time_running * 10^9  bytes/sec",
 		},
 			labels),
-		xgmiNbrTxTput3: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrTxTput3: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_3_tx_throughput",
 			Help: "Represents the number of outbound beats (each representing 32 bytes) on link 3; Throughput = BEATS/time_running * 10^9  bytes/sec",
 		},
 			labels),
-		xgmiNbrTxTput4: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrTxTput4: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_4_tx_throughput",
 			Help: "Represents the number of outbound beats (each representing 32 bytes) on link 4; Throughput = BEATS/time_running * 10^9  bytes/sec",
 		},
 			labels),
-		xgmiNbrTxTput5: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		xgmiNbrTxTput5: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "xgmi_neighbor_5_tx_throughput",
 			Help: "Represents the number of outbound beats (each representing 32 bytes) on link 5; Throughput = BEATS/time_running * 10^9  bytes/sec",
 		},
 			labels),
-		gpuEccCorrectMCA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectMCA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_mca",
 			Help: "Correctable error count in MCA block",
 		},
 			labels),
-		gpuEccUncorrectMCA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectMCA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_mca",
 			Help: "Uncorrectable error count in MCA block",
 		},
 			labels),
-		gpuEccCorrectVCN: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectVCN: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_vcn",
 			Help: "Correctable error count in VCN block",
 		},
 			labels),
-		gpuEccUncorrectVCN: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectVCN: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_vcn",
 			Help: "Uncorrectable error count in VCN block",
 		},
 			labels),
-		gpuEccCorrectJPEG: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectJPEG: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_jpeg",
 			Help: "Correctable error count in JPEG block",
 		},
 			labels),
-		gpuEccUncorrectJPEG: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectJPEG: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_jpeg",
 			Help: "Uncorrectable error count in JPEG block",
 		},
 			labels),
-		gpuEccCorrectIH: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectIH: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_ih",
 			Help: "Correctable error count in IH block",
 		},
 			labels),
-		gpuEccUncorrectIH: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectIH: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_ih",
 			Help: "Uncorrectable error count in IH block",
 		},
 			labels),
-		gpuEccCorrectMPIO: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccCorrectMPIO: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_correct_mpio",
 			Help: "Correctable error count in MPIO block",
 		},
 			labels),
-		gpuEccUncorrectMPIO: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuEccUncorrectMPIO: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_ecc_uncorrect_mpio",
 			Help: "Uncorrectable error count in MPIO block",
 		},
 			labels),
-		gpuHealth: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuHealth: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_health",
 			Help: "Health of the GPU (0 = Unhealthy | 1 = Healthy)",
 		},
 			labels),
-		gpuXgmiLinkStatsRx: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuXgmiLinkStatsRx: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_xgmi_link_rx",
 			Help: "XGMI Link Data Read in KB",
 		},
 			append([]string{"link_index"}, labels...)),
-		gpuXgmiLinkStatsTx: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuXgmiLinkStatsTx: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_xgmi_link_tx",
 			Help: "XGMI Link Data Write in KB",
 		},
 			append([]string{"link_index"}, labels...)),
-		gpuCurrAccCtr: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCurrAccCtr: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_violation_current_accumulated_counter",
 			Help: "current accumulated violation counter",
 		},
 			labels),
-		gpuProcHRA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuProcHRA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_violation_proc_hot_residency_accumulated",
 			Help: "process hot residency accumulated violation counter",
 		},
 			labels),
-		gpuPPTRA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuPPTRA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_violation_ppt_residency_accumulated",
 			Help: "package power tracking accumulated violation counter",
 		},
 			labels),
-		gpuSTRA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuSTRA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_violation_soc_thermal_residency_accumulated",
 			Help: "socket thermal accumulated violation counter",
 		},
 			labels),
-		gpuVRTRA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuVRTRA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_violation_vr_thermal_tracking_accumulated",
 			Help: "voltage rail accumulated violation counter",
 		},
 			labels),
-		gpuHBMTRA: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuHBMTRA: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_violation_hbm_thermal_residency_accumulated",
 			Help: "HBM accumulated violation counter",
 		},
 			labels),
-		gpuGfxBusyInst: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuGfxBusyInst: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_gfx_busy_instantaneous",
 			Help: "gfx busy instantaneous per accelerated compute processor(xcp) per compute core (xcc), as per partitioning of the system",
 		},
 			append([]string{"xcc_index"}, labels...)),
-		gpuVcnBusyInst: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuVcnBusyInst: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_vcn_busy_instantaneous",
 			Help: "vcn busy instantaneous per accelerated compute processor(xcp) per compute core (xcc), as per partitioning of the system",
 		},
 			append([]string{"xcc_index"}, labels...)),
-		gpuJpegBusyInst: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuJpegBusyInst: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_jpeg_busy_instantaneous",
 			Help: "jpeg busy instantaneous per accelerated compute processor(xcp) per compute core (xcc), as per partitioning of the system",
 		},
 			append([]string{"xcc_index"}, labels...)),
-		gpuGrbmGuiActivity: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuFanSpeed: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_fan_speed",
+			Help: "Current fan speed in RPM",
+		},
+			labels),
+		gpuGFXActivityAcc: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_gfx_activity_accumulated",
+			Help: "Accumulated graphics engine activity, sum of the % usage samples of the driver",
+		},
+			labels),
+		gpuMemActivityAcc: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_memory_activity_accumulated",
+			Help: "Accumulated memory activity, sum of the % usage samples of the driver",
+		},
+			labels),
+		gpuThrottlingStatus: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_throttling_status",
+			Help: "Throttling status of the GPU (0 = Not throttled | 1 = Throttled)",
+		},
+			labels),
+		gpuOperStatus: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_oper_status",
+			Help: "Operational status of the GPU (0 = Down | 1 = Up)",
+		},
+			labels),
+		gpuXgmiErrorStatus: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_xgmi_error_status",
+			Help: "XGMI error status (0 = No error | 1 = One error | 2 = Multiple errors)",
+		},
+			labels),
+		gpuXgmiLinkWidth: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_xgmi_link_width",
+			Help: "XGMI link width",
+		},
+			labels),
+		gpuXgmiLinkSpeed: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_xgmi_link_speed",
+			Help: "XGMI link speed in GB/s",
+		},
+			labels),
+		gpuPCIeWidth: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "pcie_width",
+			Help: "Current number of PCIe lanes",
+		},
+			labels),
+		gpuPCIeMaxWidth: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "pcie_max_width",
+			Help: "Maximum number of PCIe lanes",
+		},
+			labels),
+		gpuPCIeSlotType: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "pcie_slot_type",
+			Help: "PCIe card form factor in the slot_type label, always 1",
+		},
+			append([]string{"slot_type"}, labels...)),
+		gpuPCIeVersion: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "pcie_version",
+			Help: "PCIe interface version",
+		},
+			labels),
+		gpuFirmwareInfo: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_firmware_info",
+			Help: "Firmware version of a GPU component in the component and version labels, always 1",
+		},
+			append([]string{"component", "version"}, labels...)),
+		gpuHardwareInfo: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_hardware_info",
+			Help: "GPU SKU, VBIOS, VRAM and driver inventory in labels, always 1",
+		},
+			append(hwInfoLabels, labels...)),
+		gpuBadPages: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_bad_pages",
+			Help: "Number of retired and bad VRAM pages by page_status (reserved, pending, unreservable)",
+		},
+			append([]string{"page_status"}, labels...)),
+		gpuProcessUsedVRAM: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_process_used_vram",
+			Help: "VRAM used by a KFD process of the GPU in MB",
+		},
+			append(slices.Clone(processLabels), labels...)),
+		gpuProcessSDMAUsage: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_process_sdma_usage",
+			Help: "SDMA engine time used by a KFD process of the GPU in microseconds",
+		},
+			append(slices.Clone(processLabels), labels...)),
+		gpuProcessCUOccupancy: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_process_cu_occupancy",
+			Help: "Compute units occupied by the waves of a KFD process of the GPU",
+		},
+			append(slices.Clone(processLabels), labels...)),
+		gpuDerivedPower: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_derived_power",
+			Help: "Average power in W between the last two samples, from the energy consumed",
+		},
+			labels),
+		gpuDerivedPCIeUtilization: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_derived_pcie_utilization",
+			Help: "PCIe bandwidth in percent of the max speed and width line rate",
+		},
+			labels),
+		gpuDerivedXgmiUtilization: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_derived_xgmi_utilization",
+			Help: "XGMI link throughput of the busier direction in percent of the link speed between the last two samples",
+		},
+			append([]string{"link_index"}, labels...)),
+		gpuDerivedEccCorrectRate: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_derived_ecc_correct_rate",
+			Help: "Correctable ECC errors per minute between the last two samples",
+		},
+			labels),
+		gpuDerivedEccUncorrectRate: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_derived_ecc_uncorrect_rate",
+			Help: "Uncorrectable ECC errors per minute between the last two samples",
+		},
+			labels),
+		gpuDerivedPCIeReplayRate: newGaugeDesc(prometheus.GaugeOpts{
+			Name: "gpu_derived_pcie_replay_rate",
+			Help: "PCIe replays per minute between the last two samples",
+		},
+			labels),
+		gpuGrbmGuiActivity: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_grbm_gui_active",
 			Help: "Number of GPU active cycles",
 		},
 			labels),
-		gpuSqWaves: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuSqWaves: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_sq_waves",
 			Help: "Number of wavefronts dispatched to sequencers, including both new and restored wavefronts",
 		},
 			labels),
-		gpuGrbmCount: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuGrbmCount: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_grbm_count",
 			Help: "Number of free-running GPU cycles",
 		},
 			labels),
-		gpuGPUUtil: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuGPUUtil: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_gui_util_percent",
 			Help: "Percentage of the time that GUI is active",
 		},
 			labels),
-		gpuFetchSize: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuFetchSize: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_fetch_size",
 			Help: "The total kilobytes fetched from the video memory. This is measured with all extra fetches and any cache or memory effects taken into account",
 		},
 			labels),
-		gpuWriteSize: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuWriteSize: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_write_size",
 			Help: "The total kilobytes written to the video memory. This is measured with all extra fetches and any cache or memory effects taken into account",
 		},
 			labels),
-		gpuTotal16Ops: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuTotal16Ops: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_total_16_ops",
 			Help: "The number of 16 bits OPS executed",
 		},
 			labels),
-		gpuTotal32Ops: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuTotal32Ops: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_total_32_ops",
 			Help: "The number of 32 bits OPS executed",
 		},
 			labels),
-		gpuTotal64Ops: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuTotal64Ops: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_total_64_ops",
 			Help: "The number of 64 bits OPS executed",
 		},
 			labels),
-		gpuCpcStatBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcStatBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_stat_busy",
 			Help: "Number of cycles command processor-compute is busy",
 		},
 			labels),
-		gpuCpcStatIdle: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcStatIdle: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_stat_idle",
 			Help: "Number of cycles command processor-compute is idle",
 		},
 			labels),
-		gpuCpcStatStall: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcStatStall: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_stat_stall",
 			Help: "Number of cycles command processor-compute is stalled",
 		},
 			labels),
-		gpuCpcTciuBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcTciuBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_tciu_busy",
 			Help: "Number of cycles command processor-compute texture cache interface unit interface is busy",
 		},
 			labels),
-		gpuCpcTciuIdle: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcTciuIdle: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_tciu_idle",
 			Help: "Number of cycles command processor-compute texture cache interface unit interface is idle",
 		},
 			labels),
-		gpuCpcUtcl2iuBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcUtcl2iuBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_utcl2iu_busy",
 			Help: "Number of cycles command processor-compute unified translation cache (L2) interface is busy",
 		},
 			labels),
-		gpuCpcUtcl2iuIdle: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcUtcl2iuIdle: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_utcl2iu_idle",
 			Help: "Number of cycles command processor-compute unified translation cache (L2) interface is idle",
 		},
 			labels),
-		gpuCpcUtcl2iuStall: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcUtcl2iuStall: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cpc_utcl2iu_stall",
 			Help: "Number of cycles command processor-compute unified translation cache (L2) interface is stalled",
 		},
 			labels),
-		gpuCpcME1BusyForPacketDecode: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcME1BusyForPacketDecode: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_me1_busy_for_packet_decode",
 			Help: "Number of cycles command processor-compute micro engine is busy decoding packets",
 		},
 			labels),
-		gpuCpcME1Dc0SpiBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcME1Dc0SpiBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_me1_dc0_spi_busy",
 			Help: "Number of cycles command processor-compute micro engine processor is busy",
 		},
 			labels),
-		gpuCpcUtcl1StallOnTranslation: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcUtcl1StallOnTranslation: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_utcl1_stall_on_translation",
 			Help: "Number of cycles one of the unified translation caches (L1) is stalled waiting on translation",
 		},
 			labels),
-		gpuCpcAlwaysCount: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcAlwaysCount: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_always_count",
 			Help: "CPC Always Count",
 		},
 			labels),
-		gpuCpcAdcValidChunkNotAvail: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcAdcValidChunkNotAvail: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_adc_valid_chunk_not_avail",
 			Help: "CPC ADC valid chunk not available when dispatch walking is in progress at multi-xcc mode",
 		},
 			labels),
-		gpuCpcAdcDispatchAllocDone: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcAdcDispatchAllocDone: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_adc_dispatch_alloc_done",
 			Help: "CPC ADC dispatch allocation done",
 		},
 			labels),
-		gpuCpcAdcValidChunkEnd: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcAdcValidChunkEnd: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_adc_valid_chunk_end",
 			Help: "CPC ADC cralwer valid chunk end at multi-xcc mode",
 		},
 			labels),
-		gpuCpcSynFifoFullLevel: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcSynFifoFullLevel: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_sync_fifo_full_level",
 			Help: "CPC SYNC FIFO full last cycles",
 		},
 			labels),
-		gpuCpcSynFifoFull: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcSynFifoFull: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_sync_fifo_full",
 			Help: "CPC SYNC FIFO full times",
 		},
 			labels),
-		gpuCpcGdBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcGdBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_gd_busy",
 			Help: "CPC ADC busy",
 		},
 			labels),
-		gpuCpcTgSend: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcTgSend: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_tg_send",
 			Help: "CPC ADC thread group send",
 		},
 			labels),
-		gpuCpcWalkNextChunk: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcWalkNextChunk: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_walk_next_chunk",
 			Help: "CPC ADC walking next valid chunk at multi-xcc mode",
 		},
 			labels),
-		gpuCpcStalledBySe0Spi: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcStalledBySe0Spi: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_stalled_by_se0_spi",
 			Help: "CPC ADC csdata stalled by SE0SPI",
 		},
 			labels),
-		gpuCpcStalledBySe1Spi: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcStalledBySe1Spi: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_stalled_by_se1_spi",
 			Help: "CPC ADC csdata stalled by SE1SPI",
 		},
 			labels),
-		gpuCpcStalledBySe2Spi: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcStalledBySe2Spi: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_stalled_by_se2_spi",
 			Help: "CPC ADC csdata stalled by SE2SPI",
 		},
 			labels),
-		gpuCpcStalledBySe3Spi: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcStalledBySe3Spi: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_stalled_by_se3_spi",
 			Help: "CPC ADC csdata stalled by SE3SPI",
 		},
 			labels),
-		gpuCpcLteAll: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcLteAll: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_lte_all",
 			Help: "CPC Sync counter LteAll, only Master XCD cares LteAll",
 		},
 			labels),
-		gpuCpcSyncWrreqFifoBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcSyncWrreqFifoBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_sync_wrreq_fifo_busy",
 			Help: "CPC Sync Counter Request Fifo is not empty",
 		},
 			labels),
-		gpuCpcCaneBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcCaneBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cane_busy",
 			Help: "CPC CANE bus busy, means there are inflight sync counter requests",
 		},
 			labels),
-		gpuCpcCaneStall: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpcCaneStall: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpc_cane_stall",
 			Help: "CPC Sync counter sending is stalled by CANE",
 		},
 			labels),
-		gpuCpfCmpUtcl1StallOnTrnsalation: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpfCmpUtcl1StallOnTrnsalation: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpf_cmp_utcl1_stall_on_translation",
 			Help: "One of the Compute UTCL1s is stalled waiting on translation, XNACK or PENDING response",
 		},
 			labels),
-		gpuCpfStatBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpfStatBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpf_cpf_stat_busy",
 			Help: "CPF Busy",
 		},
 			labels),
-		gpuCpfStatIdle: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpfStatIdle: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpf_cpf_stat_idle",
 			Help: "CPF Idle",
 		},
 			labels),
-		gpuCpfStatStall: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpfStatStall: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpf_cpf_stat_stall",
 			Help: "CPF Stalled",
 		},
 			labels),
-		gpuCpfStatTciuBusy: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpfStatTciuBusy: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpf_cpf_tciu_busy",
 			Help: "CPF TCIU interface Busy",
 		},
 			labels),
-		gpuCpfStatTciuIdle: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpfStatTciuIdle: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpf_cpf_tciu_idle",
 			Help: "CPF TCIU interface Idle",
 		},
 			labels),
-		gpuCpfStatTciuStall: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuCpfStatTciuStall: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_cpf_cpf_tciu_stall",
 			Help: "CPF TCIU interface Stalled waiting on Free, Tags",
 		},
 			labels),
-		gpuOccPercent: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuOccPercent: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_occupancy_percent",
 			Help: "GPU Occupancy as % of maximum",
 		},
 			labels),
-		gpuTensorActivePercent: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuTensorActivePercent: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_tensor_active_percent",
 			Help: "MFMA Utililization Unit: percent",
 		},
 			labels),
-		gpuValuPipeIssueUtil: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuValuPipeIssueUtil: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_valu_pipe_issue_util",
 			Help: "Percentage of the time that GUI is active",
 		},
 			labels),
-		gpuSMActive: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuSMActive: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_sm_active",
 			Help: "The percentage of GPUTime vector ALU instructions are processed. Value range: 0% (bad) to 100% (optimal)",
 		},
 			labels),
-		gpuOccElapsed: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuOccElapsed: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_occupancy_elapsed",
 			Help: "Number of GPU active cycles",
 		},
 			labels),
-		gpuOccPerActiveCU: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		gpuOccPerActiveCU: newGaugeDesc(prometheus.GaugeOpts{
 			Name: "gpu_prof_occupancy_per_active_cu",
 			Help: "Mean occupancy per active compute unit",
 		},
@@ -1412,6 +1638,8 @@ func (ga *GPUAgentClient) initPrometheusMetrics() {
 }
 
 func (ga *GPUAgentClient) initFieldRegistration() error {
+	metricTypeMode := ga.mh.GetMetricTypeMode()
+	logger.Log.Printf("cumulative fields metric type %v", metricTypeMode)
 	for field, enabled := range exportFieldMap {
 		if !enabled {
 			continue
@@ -1421,10 +1649,18 @@ func (ga *GPUAgentClient) initFieldRegistration() error {
 			logger.Log.Printf("invalid field found ignore %v", field)
 			continue
 		}
-		if err := ga.mh.RegisterMetric(prommetric.Metric); err != nil {
-			logger.Log.Printf("Field %v registration failed with err : %v", field, err)
+		prommetric.Metric.enabled = true
+		prommetric.Metric.field = field
+		if isCumulativeField(field) {
+			prommetric.Metric.setMetricType(metricTypeMode)
 		}
 	}
+	// previous snapshot was built against the old descriptors
+	ga.metricsSnapshot.Store(newMetricSet(nil))
+	if err := ga.mh.RegisterMetric(ga); err != nil {
+		logger.Log.Printf("gpu fields registration failed with err : %v", err)
+		return err
+	}
 
 	return nil
 }
@@ -1434,9 +1670,13 @@ func (ga *GPUAgentClient) InitConfigs() error {
 
 	initPodExtraLabels(filedConfigs)
 	initCustomLabels(filedConfigs)
+	initModelProfiles(filedConfigs)
 	ga.initLabelConfigs(filedConfigs)
+	applyModelProfileLabels()
 	initFieldConfig(filedConfigs)
+	applyModelProfileFields()
 	ga.initProfilerMetrics(filedConfigs)
+	ga.initProcessMetrics(filedConfigs)
 	initGPUSelectorConfig(filedConfigs)
 	ga.initPrometheusMetrics()
 	ga.initProfilerMetricsField()
@@ -1455,6 +1695,8 @@ func getGPUInstanceID(gpu *amdgpu.GPU) int {
 }
 
 func (ga *GPUAgentClient) UpdateStaticMetrics() error {
+	ms := newMetricSet(ga.counters)
+	defer ga.publishMetrics(ms)
 	// send the req to gpuclient
 	resp, partitionMap, err := ga.getGPUs()
 	if err != nil {
@@ -1471,24 +1713,28 @@ func (ga *GPUAgentClient) UpdateStaticMetrics() error {
 
 	k8PodLabelsMap, err = ga.FetchPodLabelsForNode()
 	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
-	ga.m.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(resp.Response)))
+	ms.set(ga.m.gpuNodesTotal, nonGpuLabels, float64(len(resp.Response)))
 	// do this only once as the health monitoring thread will
 	// update periodically. this is required only for first state
 	// of the metrics pull response from prometheus
 	newGPUState := ga.processEccErrorMetrics(resp.Response, wls)
+	badPages := ga.getBadPages()
+	ga.derived.observe(resp.Response, ga.lastGPUGetTime())
 	usedVRAM, err := ga.fsysDeviceHandler.GetAllUsedVRAM()
 	if err != nil {
 		logger.Log.Printf("GetAllUsedVRAM failed with err : %v", err)
 	}
 	_ = ga.updateNewHealthState(newGPUState)
 	for _, gpu := range resp.Response {
-		ga.updateGPUInfoToMetrics(wls, gpu, partitionMap, nil, usedVRAM)
+		ga.updateGPUInfoToMetrics(ms, wls, gpu, partitionMap, nil, usedVRAM, badPages)
 	}
 	return nil
 }
 
 func (ga *GPUAgentClient) UpdateMetricsStats() error {
-	return ga.getMetricsAll()
+	ms := newMetricSet(ga.counters)
+	defer ga.publishMetrics(ms)
+	return ga.getMetricsAll(ms)
 }
 
 func (ga *GPUAgentClient) getWorkloadInfo(wls map[string]scheduler.Workload, gpu *amdgpu.GPU) *scheduler.Workload {
@@ -1534,6 +1780,11 @@ func (ga *GPUAgentClient) populateLabelsFromGPU(
 	}
 	labels := make(map[string]string)
 	var parentPartition *amdgpu.GPU
+	// labels of the gpu model profile, others are exported empty
+	var gpuLabels map[string]bool
+	if gpu != nil {
+		gpuLabels = ga.gpuModelProfile(gpu).exportLabels()
+	}
 
 	if partitionMap != nil && gpu != nil && gpu.Status.PCIeStatus != nil {
 		gpuPcieAddr := strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId)
@@ -1544,7 +1795,7 @@ func (ga *GPUAgentClient) populateLabelsFromGPU(
 	}
 
 	for ckey, enabled := range exportLables {
-		if !enabled {
+		if !enabled || (gpuLabels != nil && !gpuLabels[ckey]) {
 			continue
 		}
 		key := strings.ToLower(ckey)
@@ -1708,43 +1959,67 @@ func (ga *GPUAgentClient) exporterEnabledGPU(instance int) bool {
 
 }
 
+// hardwareInfo returns the gpu_hardware_info label values of the GPU
+func hardwareInfo(status *amdgpu.GPUStatus) map[string]string {
+	info := map[string]string{
+		"card_sku":          status.GetCardSKU(),
+		"vbios_part_number": status.GetVBIOSPartNumber(),
+		"vbios_version":     status.GetVBIOSVersion(),
+		"vram_vendor":       status.GetMemoryVendor(),
+		"driver_version":    status.GetDriverVersion(),
+	}
+	if vram := status.GetVRAMStatus(); vram != nil {
+		if vram.Type != amdgpu.VRAMType_VRAM_TYPE_NONE {
+			info["vram_type"] = strings.ToLower(strings.TrimPrefix(vram.Type.String(), "VRAM_TYPE_"))
+		}
+		if vram.Vendor != amdgpu.VRAMVendor_VRAM_VENDOR_NONE {
+			info["vram_vendor"] = strings.ToLower(strings.TrimPrefix(vram.Vendor.String(), "VRAM_VENDOR_"))
+		}
+	}
+	return info
+}
+
 func (ga *GPUAgentClient) updateGPUInfoToMetrics(
+	ms *metricSet,
 	wls map[string]scheduler.Workload,
 	gpu *amdgpu.GPU,
 	partitionMap map[string]*amdgpu.GPU,
 	profMetrics map[string]float64,
 	usedPartitionVram map[string]float64,
+	badPages map[string]badPageCounts,
 ) {
 	if !ga.exporterEnabledGPU(getGPUInstanceID(gpu)) {
 		return
 	}
 
+	// fields of the gpu model profile only
+	ms = ms.withFields(ga.gpuModelProfile(gpu).exportFields())
 	labels := ga.populateLabelsFromGPU(wls, gpu, partitionMap)
 	labelsWithIndex := ga.populateLabelsFromGPU(wls, gpu, partitionMap)
 	status := gpu.Status
 	stats := gpu.Stats
-	ga.m.gpuPackagePower.With(labels).Set(utils.NormalizeUint64(stats.PackagePower))
-	ga.m.gpuAvgPkgPower.With(labels).Set(utils.NormalizeUint64(stats.AvgPackagePower))
+	ms.set(ga.m.gpuPackagePower, labels, utils.NormalizeUint64(stats.PackagePower))
+	ms.set(ga.m.gpuAvgPkgPower, labels, utils.NormalizeUint64(stats.AvgPackagePower))
 
 	// export health state only if available
 	gpuid := fmt.Sprintf("%v", getGPUInstanceID(gpu))
 	if hstate, ok := ga.healthState[gpuid]; ok {
 		if hstate.Health == strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()) {
-			ga.m.gpuHealth.With(labels).Set(1)
+			ms.set(ga.m.gpuHealth, labels, 1)
 		} else {
-			ga.m.gpuHealth.With(labels).Set(0)
+			ms.set(ga.m.gpuHealth, labels, 0)
 		}
 	}
 
 	// gpu temp stats
 	tempStats := stats.Temperature
 	if tempStats != nil {
-		ga.m.gpuEdgeTemp.With(labels).Set(utils.NormalizeFloat(tempStats.EdgeTemperature))
-		ga.m.gpuJunctionTemp.With(labels).Set(utils.NormalizeFloat(tempStats.JunctionTemperature))
-		ga.m.gpuMemoryTemp.With(labels).Set(utils.NormalizeFloat(tempStats.MemoryTemperature))
+		ms.set(ga.m.gpuEdgeTemp, labels, utils.NormalizeFloat(tempStats.EdgeTemperature))
+		ms.set(ga.m.gpuJunctionTemp, labels, utils.NormalizeFloat(tempStats.JunctionTemperature))
+		ms.set(ga.m.gpuMemoryTemp, labels, utils.NormalizeFloat(tempStats.MemoryTemperature))
 		for j, temp := range tempStats.HBMTemperature {
 			labelsWithIndex["hbm_index"] = fmt.Sprintf("%v", j)
-			ga.m.gpuHBMTemp.With(labelsWithIndex).Set(utils.NormalizeFloat(temp))
+			ms.set(ga.m.gpuHBMTemp, labelsWithIndex, utils.NormalizeFloat(temp))
 		}
 		delete(labelsWithIndex, "hbm_index")
 	}
@@ -1752,38 +2027,38 @@ func (ga *GPUAgentClient) updateGPUInfoToMetrics(
 	// gpu usage
 	gpuUsage := stats.Usage
 	if gpuUsage != nil {
-		ga.m.gpuGFXActivity.With(labels).Set(utils.NormalizeUint64(gpuUsage.GFXActivity))
-		ga.m.gpuUMCActivity.With(labels).Set(utils.NormalizeUint64(gpuUsage.UMCActivity))
-		ga.m.gpuMMAActivity.With(labels).Set(utils.NormalizeUint64(gpuUsage.MMActivity))
+		ms.set(ga.m.gpuGFXActivity, labels, utils.NormalizeUint64(gpuUsage.GFXActivity))
+		ms.set(ga.m.gpuUMCActivity, labels, utils.NormalizeUint64(gpuUsage.UMCActivity))
+		ms.set(ga.m.gpuMMAActivity, labels, utils.NormalizeUint64(gpuUsage.MMActivity))
 		for j, act := range gpuUsage.VCNActivity {
 			labelsWithIndex["vcn_index"] = fmt.Sprintf("%v", j)
-			ga.m.gpuVCNActivity.With(labelsWithIndex).Set(utils.NormalizeUint64(act))
+			ms.set(ga.m.gpuVCNActivity, labelsWithIndex, utils.NormalizeUint64(act))
 		}
 		delete(labelsWithIndex, "vcn_index")
 		for j, act := range gpuUsage.JPEGActivity {
 			labelsWithIndex["jpeg_index"] = fmt.Sprintf("%v", j)
-			ga.m.gpuJPEGActivity.With(labelsWithIndex).Set(utils.NormalizeUint64(act))
+			ms.set(ga.m.gpuJPEGActivity, labelsWithIndex, utils.NormalizeUint64(act))
 		}
 		delete(labelsWithIndex, "jpeg_index")
 		for j, act := range gpuUsage.GFXBusyInst {
 			labelsWithIndex["xcc_index"] = fmt.Sprintf("%v", j)
 			// exporter only valid data
 			if utils.IsValueApplicable(act) {
-				ga.m.gpuGfxBusyInst.With(labelsWithIndex).Set(utils.NormalizeUint64(act))
+				ms.set(ga.m.gpuGfxBusyInst, labelsWithIndex, utils.NormalizeUint64(act))
 			}
 		}
 		for j, act := range gpuUsage.VCNBusyInst {
 			labelsWithIndex["xcc_index"] = fmt.Sprintf("%v", j)
 			// exporter only valid data
 			if utils.IsValueApplicable(act) {
-				ga.m.gpuVcnBusyInst.With(labelsWithIndex).Set(utils.NormalizeUint64(act))
+				ms.set(ga.m.gpuVcnBusyInst, labelsWithIndex, utils.NormalizeUint64(act))
 			}
 		}
 		for j, act := range gpuUsage.JPEGBusyInst {
 			labelsWithIndex["xcc_index"] = fmt.Sprintf("%v", j)
 			// exporter only valid data
 			if utils.IsValueApplicable(act) {
-				ga.m.gpuJpegBusyInst.With(labelsWithIndex).Set(utils.NormalizeUint64(act))
+				ms.set(ga.m.gpuJpegBusyInst, labelsWithIndex, utils.NormalizeUint64(act))
 			}
 		}
 		delete(labelsWithIndex, "xcc_index")
@@ -1791,30 +2066,92 @@ func (ga *GPUAgentClient) updateGPUInfoToMetrics(
 
 	volt := stats.Voltage
 	if volt != nil {
-		ga.m.gpuVoltage.With(labels).Set(utils.NormalizeUint64(volt.Voltage))
-		ga.m.gpuGFXVoltage.With(labels).Set(utils.NormalizeUint64(volt.GFXVoltage))
-		ga.m.gpuMemVoltage.With(labels).Set(utils.NormalizeUint64(volt.MemoryVoltage))
+		ms.set(ga.m.gpuVoltage, labels, utils.NormalizeUint64(volt.Voltage))
+		ms.set(ga.m.gpuGFXVoltage, labels, utils.NormalizeUint64(volt.GFXVoltage))
+		ms.set(ga.m.gpuMemVoltage, labels, utils.NormalizeUint64(volt.MemoryVoltage))
 	}
 
 	// pcie status
 	pcieStatus := status.PCIeStatus
 	if pcieStatus != nil {
-		ga.m.gpuPCIeSpeed.With(labels).Set(utils.NormalizeUint64(pcieStatus.Speed))
-		ga.m.gpuPCIeMaxSpeed.With(labels).Set(utils.NormalizeUint64(pcieStatus.MaxSpeed))
-		ga.m.gpuPCIeBandwidth.With(labels).Set(utils.NormalizeUint64(pcieStatus.Bandwidth))
+		ms.set(ga.m.gpuPCIeSpeed, labels, utils.NormalizeUint64(pcieStatus.Speed))
+		ms.set(ga.m.gpuPCIeMaxSpeed, labels, utils.NormalizeUint64(pcieStatus.MaxSpeed))
+		ms.set(ga.m.gpuPCIeBandwidth, labels, utils.NormalizeUint64(pcieStatus.Bandwidth))
+		ms.set(ga.m.gpuPCIeWidth, labels, utils.NormalizeUint64(pcieStatus.Width))
+		ms.set(ga.m.gpuPCIeMaxWidth, labels, utils.NormalizeUint64(pcieStatus.MaxWidth))
+		ms.set(ga.m.gpuPCIeVersion, labels, utils.NormalizeUint64(pcieStatus.Version))
+		if slotType, ok := pcieSlotTypes[pcieStatus.SlotType]; ok {
+			labelsWithIndex["slot_type"] = slotType
+			ms.set(ga.m.gpuPCIeSlotType, labelsWithIndex, 1)
+			delete(labelsWithIndex, "slot_type")
+		}
+	}
+
+	// gpu, xgmi status, unknown states are not exported
+	if v, ok := throttlingStatusValues[status.ThrottlingStatus]; ok {
+		ms.set(ga.m.gpuThrottlingStatus, labels, v)
+	}
+	if v, ok := operStatusValues[status.OperStatus]; ok {
+		ms.set(ga.m.gpuOperStatus, labels, v)
+	}
+	xgmiStatus := status.XGMIStatus
+	if xgmiStatus != nil {
+		if v, ok := xgmiErrorStatusValues[xgmiStatus.ErrorStatus]; ok {
+			ms.set(ga.m.gpuXgmiErrorStatus, labels, v)
+		}
+		ms.set(ga.m.gpuXgmiLinkWidth, labels, utils.NormalizeUint64(xgmiStatus.Width))
+		ms.set(ga.m.gpuXgmiLinkSpeed, labels, utils.NormalizeUint64(xgmiStatus.Speed))
+	}
+
+	// firmware and hardware inventory
+	for _, fw := range status.FirmwareVersion {
+		if fw.GetFirmware() == "" {
+			continue
+		}
+		labelsWithIndex["component"] = fw.GetFirmware()
+		labelsWithIndex["version"] = fw.GetVersion()
+		ms.set(ga.m.gpuFirmwareInfo, labelsWithIndex, 1)
+	}
+	delete(labelsWithIndex, "component")
+	delete(labelsWithIndex, "version")
+	hwInfo := hardwareInfo(status)
+	for _, label := range ga.m.hardwareInfoLabels {
+		labelsWithIndex[label] = hwInfo[label]
+	}
+	ms.set(ga.m.gpuHardwareInfo, labelsWithIndex, 1)
+	for _, label := range ga.m.hardwareInfoLabels {
+		delete(labelsWithIndex, label)
 	}
 
+	// bad pages, not exported until the first bad page get succeeded
+	if badPages != nil {
+		id, _ := uuid.FromBytes(gpu.Spec.Id)
+		counts := badPages[id.String()]
+		for pageStatus, label := range pageStatusLabels {
+			labelsWithIndex["page_status"] = label
+			ms.set(ga.m.gpuBadPages, labelsWithIndex, float64(counts[pageStatus]))
+		}
+		delete(labelsWithIndex, "page_status")
+	}
+
+	ga.updateProcessMetrics(ms, labels, gpu, wls)
+	ga.updateDerivedMetrics(ms, labels, labelsWithIndex, gpu)
+
+	ms.set(ga.m.gpuFanSpeed, labels, utils.NormalizeUint64(stats.FanSpeed))
+	ms.set(ga.m.gpuGFXActivityAcc, labels, utils.NormalizeUint64(stats.GFXActivityAccumulated))
+	ms.set(ga.m.gpuMemActivityAcc, labels, utils.NormalizeUint64(stats.MemoryActivityAccumulated))
+
 	// pcie stats
 	pcieStats := stats.PCIeStats
 	if pcieStats != nil {
-		ga.m.gpuPCIeReplayCount.With(labels).Set(utils.NormalizeUint64(pcieStats.ReplayCount))
-		ga.m.gpuPCIeRecoveryCount.With(labels).Set(utils.NormalizeUint64(pcieStats.RecoveryCount))
-		ga.m.gpuPCIeReplayRolloverCount.With(labels).Set(utils.NormalizeUint64(pcieStats.ReplayRolloverCount))
-		ga.m.gpuPCIeNACKSentCount.With(labels).Set(utils.NormalizeUint64(pcieStats.NACKSentCount))
-		ga.m.gpuPCIeNACKReceivedCount.With(labels).Set(utils.NormalizeUint64(pcieStats.NACKReceivedCount))
+		ms.set(ga.m.gpuPCIeReplayCount, labels, utils.NormalizeUint64(pcieStats.ReplayCount))
+		ms.set(ga.m.gpuPCIeRecoveryCount, labels, utils.NormalizeUint64(pcieStats.RecoveryCount))
+		ms.set(ga.m.gpuPCIeReplayRolloverCount, labels, utils.NormalizeUint64(pcieStats.ReplayRolloverCount))
+		ms.set(ga.m.gpuPCIeNACKSentCount, labels, utils.NormalizeUint64(pcieStats.NACKSentCount))
+		ms.set(ga.m.gpuPCIeNACKReceivedCount, labels, utils.NormalizeUint64(pcieStats.NACKReceivedCount))
 	}
 
-	ga.m.gpuEnergyConsumed.With(labels).Set(stats.EnergyConsumed)
+	ms.set(ga.m.gpuEnergyConsumed, labels, stats.EnergyConsumed)
 
 	// clock status
 	clockStatus := status.ClockStatus
@@ -1822,90 +2159,90 @@ func (ga *GPUAgentClient) updateGPUInfoToMetrics(
 		for j, clock := range clockStatus {
 			labelsWithIndex["clock_index"] = fmt.Sprintf("%v", j)
 			labelsWithIndex["clock_type"] = fmt.Sprintf("%v", clock.Type.String())
-			ga.m.gpuClock.With(labelsWithIndex).Set(utils.NormalizeUint64(clock.Frequency))
+			ms.set(ga.m.gpuClock, labelsWithIndex, utils.NormalizeUint64(clock.Frequency))
 		}
 		delete(labelsWithIndex, "clock_index")
 		delete(labelsWithIndex, "clock_type")
 	}
 
-	ga.m.gpuPowerUsage.With(labels).Set(utils.NormalizeUint64(stats.PowerUsage))
-
-	ga.m.gpuEccCorrectTotal.With(labels).Set(utils.NormalizeUint64(stats.TotalCorrectableErrors))
-	ga.m.gpuEccUncorrectTotal.With(labels).Set(utils.NormalizeUint64(stats.TotalUncorrectableErrors))
-	ga.m.gpuEccCorrectSDMA.With(labels).Set(utils.NormalizeUint64(stats.SDMACorrectableErrors))
-	ga.m.gpuEccUncorrectSDMA.With(labels).Set(utils.NormalizeUint64(stats.SDMAUncorrectableErrors))
-	ga.m.gpuEccCorrectGFX.With(labels).Set(utils.NormalizeUint64(stats.GFXCorrectableErrors))
-	ga.m.gpuEccUncorrectGFX.With(labels).Set(utils.NormalizeUint64(stats.GFXUncorrectableErrors))
-	ga.m.gpuEccCorrectMMHUB.With(labels).Set(utils.NormalizeUint64(stats.MMHUBCorrectableErrors))
-	ga.m.gpuEccUncorrectMMHUB.With(labels).Set(utils.NormalizeUint64(stats.MMHUBUncorrectableErrors))
-	ga.m.gpuEccCorrectATHUB.With(labels).Set(utils.NormalizeUint64(stats.ATHUBCorrectableErrors))
-	ga.m.gpuEccUncorrectATHUB.With(labels).Set(utils.NormalizeUint64(stats.ATHUBUncorrectableErrors))
-
-	ga.m.gpuEccCorrectBIF.With(labels).Set(utils.NormalizeUint64(stats.BIFCorrectableErrors))
-	ga.m.gpuEccUncorrectBIF.With(labels).Set(utils.NormalizeUint64(stats.BIFUncorrectableErrors))
-	ga.m.gpuEccCorrectHDP.With(labels).Set(utils.NormalizeUint64(stats.HDPCorrectableErrors))
-	ga.m.gpuEccUncorrectHDP.With(labels).Set(utils.NormalizeUint64(stats.HDPUncorrectableErrors))
-	ga.m.gpuEccCorrectXgmiWAFL.With(labels).Set(utils.NormalizeUint64(stats.XGMIWAFLCorrectableErrors))
-	ga.m.gpuEccUncorrectXgmiWAFL.With(labels).Set(utils.NormalizeUint64(stats.XGMIWAFLUncorrectableErrors))
-	ga.m.gpuEccCorrectDF.With(labels).Set(utils.NormalizeUint64(stats.DFCorrectableErrors))
-	ga.m.gpuEccUncorrectDF.With(labels).Set(utils.NormalizeUint64(stats.DFUncorrectableErrors))
-	ga.m.gpuEccCorrectSMN.With(labels).Set(utils.NormalizeUint64(stats.SMNCorrectableErrors))
-	ga.m.gpuEccUncorrectSMN.With(labels).Set(utils.NormalizeUint64(stats.SMNUncorrectableErrors))
-	ga.m.gpuEccCorrectSEM.With(labels).Set(utils.NormalizeUint64(stats.SEMCorrectableErrors))
-	ga.m.gpuEccUncorrectSEM.With(labels).Set(utils.NormalizeUint64(stats.SEMUncorrectableErrors))
-
-	ga.m.gpuEccCorrectMP0.With(labels).Set(utils.NormalizeUint64(stats.MP0CorrectableErrors))
-	ga.m.gpuEccUncorrectMP0.With(labels).Set(utils.NormalizeUint64(stats.MP0UncorrectableErrors))
-	ga.m.gpuEccCorrectMP1.With(labels).Set(utils.NormalizeUint64(stats.MP1CorrectableErrors))
-	ga.m.gpuEccUncorrectMP1.With(labels).Set(utils.NormalizeUint64(stats.MP1UncorrectableErrors))
-	ga.m.gpuEccCorrectFUSE.With(labels).Set(utils.NormalizeUint64(stats.FUSECorrectableErrors))
-	ga.m.gpuEccUncorrectFUSE.With(labels).Set(utils.NormalizeUint64(stats.FUSEUncorrectableErrors))
-	ga.m.gpuEccCorrectUMC.With(labels).Set(utils.NormalizeUint64(stats.UMCCorrectableErrors))
-	ga.m.gpuEccUncorrectUMC.With(labels).Set(utils.NormalizeUint64(stats.UMCUncorrectableErrors))
-
-	ga.m.gpuEccCorrectMCA.With(labels).Set(utils.NormalizeUint64(stats.MCACorrectableErrors))
-	ga.m.gpuEccUncorrectMCA.With(labels).Set(utils.NormalizeUint64(stats.MCAUncorrectableErrors))
-
-	ga.m.gpuEccCorrectVCN.With(labels).Set(utils.NormalizeUint64(stats.VCNCorrectableErrors))
-	ga.m.gpuEccUncorrectVCN.With(labels).Set(utils.NormalizeUint64(stats.VCNUncorrectableErrors))
-
-	ga.m.gpuEccCorrectJPEG.With(labels).Set(utils.NormalizeUint64(stats.JPEGCorrectableErrors))
-	ga.m.gpuEccUncorrectJPEG.With(labels).Set(utils.NormalizeUint64(stats.JPEGUncorrectableErrors))
-
-	ga.m.gpuEccCorrectIH.With(labels).Set(utils.NormalizeUint64(stats.IHCorrectableErrors))
-	ga.m.gpuEccUncorrectIH.With(labels).Set(utils.NormalizeUint64(stats.IHUncorrectableErrors))
-
-	ga.m.gpuEccCorrectMPIO.With(labels).Set(utils.NormalizeUint64(stats.MPIOCorrectableErrors))
-	ga.m.gpuEccUncorrectMPIO.With(labels).Set(utils.NormalizeUint64(stats.MPIOUncorrectableErrors))
-
-	ga.m.xgmiNbrNopTx0.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor0TxNOPs))
-	ga.m.xgmiNbrReqTx0.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor0TxRequests))
-	ga.m.xgmiNbrRespTx0.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor0TxResponses))
-	ga.m.xgmiNbrBeatsTx0.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor0TXBeats))
-
-	ga.m.xgmiNbrNopTx1.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor1TxNOPs))
-	ga.m.xgmiNbrReqTx1.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor1TxRequests))
-	ga.m.xgmiNbrRespTx1.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor1TxResponses))
-	ga.m.xgmiNbrBeatsTx1.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor1TXBeats))
-
-	ga.m.xgmiNbrTxTput0.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor0TxThroughput))
-	ga.m.xgmiNbrTxTput1.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor1TxThroughput))
-	ga.m.xgmiNbrTxTput2.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor2TxThroughput))
-	ga.m.xgmiNbrTxTput3.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor3TxThroughput))
-	ga.m.xgmiNbrTxTput4.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor4TxThroughput))
-	ga.m.xgmiNbrTxTput5.With(labels).Set(utils.NormalizeUint64(stats.XGMINeighbor5TxThroughput))
+	ms.set(ga.m.gpuPowerUsage, labels, utils.NormalizeUint64(stats.PowerUsage))
+
+	ms.set(ga.m.gpuEccCorrectTotal, labels, utils.NormalizeUint64(stats.TotalCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectTotal, labels, utils.NormalizeUint64(stats.TotalUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectSDMA, labels, utils.NormalizeUint64(stats.SDMACorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectSDMA, labels, utils.NormalizeUint64(stats.SDMAUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectGFX, labels, utils.NormalizeUint64(stats.GFXCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectGFX, labels, utils.NormalizeUint64(stats.GFXUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectMMHUB, labels, utils.NormalizeUint64(stats.MMHUBCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectMMHUB, labels, utils.NormalizeUint64(stats.MMHUBUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectATHUB, labels, utils.NormalizeUint64(stats.ATHUBCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectATHUB, labels, utils.NormalizeUint64(stats.ATHUBUncorrectableErrors))
+
+	ms.set(ga.m.gpuEccCorrectBIF, labels, utils.NormalizeUint64(stats.BIFCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectBIF, labels, utils.NormalizeUint64(stats.BIFUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectHDP, labels, utils.NormalizeUint64(stats.HDPCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectHDP, labels, utils.NormalizeUint64(stats.HDPUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectXgmiWAFL, labels, utils.NormalizeUint64(stats.XGMIWAFLCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectXgmiWAFL, labels, utils.NormalizeUint64(stats.XGMIWAFLUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectDF, labels, utils.NormalizeUint64(stats.DFCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectDF, labels, utils.NormalizeUint64(stats.DFUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectSMN, labels, utils.NormalizeUint64(stats.SMNCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectSMN, labels, utils.NormalizeUint64(stats.SMNUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectSEM, labels, utils.NormalizeUint64(stats.SEMCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectSEM, labels, utils.NormalizeUint64(stats.SEMUncorrectableErrors))
+
+	ms.set(ga.m.gpuEccCorrectMP0, labels, utils.NormalizeUint64(stats.MP0CorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectMP0, labels, utils.NormalizeUint64(stats.MP0UncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectMP1, labels, utils.NormalizeUint64(stats.MP1CorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectMP1, labels, utils.NormalizeUint64(stats.MP1UncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectFUSE, labels, utils.NormalizeUint64(stats.FUSECorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectFUSE, labels, utils.NormalizeUint64(stats.FUSEUncorrectableErrors))
+	ms.set(ga.m.gpuEccCorrectUMC, labels, utils.NormalizeUint64(stats.UMCCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectUMC, labels, utils.NormalizeUint64(stats.UMCUncorrectableErrors))
+
+	ms.set(ga.m.gpuEccCorrectMCA, labels, utils.NormalizeUint64(stats.MCACorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectMCA, labels, utils.NormalizeUint64(stats.MCAUncorrectableErrors))
+
+	ms.set(ga.m.gpuEccCorrectVCN, labels, utils.NormalizeUint64(stats.VCNCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectVCN, labels, utils.NormalizeUint64(stats.VCNUncorrectableErrors))
+
+	ms.set(ga.m.gpuEccCorrectJPEG, labels, utils.NormalizeUint64(stats.JPEGCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectJPEG, labels, utils.NormalizeUint64(stats.JPEGUncorrectableErrors))
+
+	ms.set(ga.m.gpuEccCorrectIH, labels, utils.NormalizeUint64(stats.IHCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectIH, labels, utils.NormalizeUint64(stats.IHUncorrectableErrors))
+
+	ms.set(ga.m.gpuEccCorrectMPIO, labels, utils.NormalizeUint64(stats.MPIOCorrectableErrors))
+	ms.set(ga.m.gpuEccUncorrectMPIO, labels, utils.NormalizeUint64(stats.MPIOUncorrectableErrors))
+
+	ms.set(ga.m.xgmiNbrNopTx0, labels, utils.NormalizeUint64(stats.XGMINeighbor0TxNOPs))
+	ms.set(ga.m.xgmiNbrReqTx0, labels, utils.NormalizeUint64(stats.XGMINeighbor0TxRequests))
+	ms.set(ga.m.xgmiNbrRespTx0, labels, utils.NormalizeUint64(stats.XGMINeighbor0TxResponses))
+	ms.set(ga.m.xgmiNbrBeatsTx0, labels, utils.NormalizeUint64(stats.XGMINeighbor0TXBeats))
+
+	ms.set(ga.m.xgmiNbrNopTx1, labels, utils.NormalizeUint64(stats.XGMINeighbor1TxNOPs))
+	ms.set(ga.m.xgmiNbrReqTx1, labels, utils.NormalizeUint64(stats.XGMINeighbor1TxRequests))
+	ms.set(ga.m.xgmiNbrRespTx1, labels, utils.NormalizeUint64(stats.XGMINeighbor1TxResponses))
+	ms.set(ga.m.xgmiNbrBeatsTx1, labels, utils.NormalizeUint64(stats.XGMINeighbor1TXBeats))
+
+	ms.set(ga.m.xgmiNbrTxTput0, labels, utils.NormalizeUint64(stats.XGMINeighbor0TxThroughput))
+	ms.set(ga.m.xgmiNbrTxTput1, labels, utils.NormalizeUint64(stats.XGMINeighbor1TxThroughput))
+	ms.set(ga.m.xgmiNbrTxTput2, labels, utils.NormalizeUint64(stats.XGMINeighbor2TxThroughput))
+	ms.set(ga.m.xgmiNbrTxTput3, labels, utils.NormalizeUint64(stats.XGMINeighbor3TxThroughput))
+	ms.set(ga.m.xgmiNbrTxTput4, labels, utils.NormalizeUint64(stats.XGMINeighbor4TxThroughput))
+	ms.set(ga.m.xgmiNbrTxTput5, labels, utils.NormalizeUint64(stats.XGMINeighbor5TxThroughput))
 
 	vramUsage := stats.VRAMUsage
 	vramStatus := status.GetVRAMStatus()
 	var totalVRAM, usedVRAM, freeVRAM float64
 	if vramUsage != nil {
-		ga.m.gpuTotalVisibleVram.With(labels).Set(utils.NormalizeUint64(vramUsage.TotalVisibleVRAM))
-		ga.m.gpuUsedVisibleVram.With(labels).Set(utils.NormalizeUint64(vramUsage.UsedVisibleVRAM))
-		ga.m.gpuFreeVisibleVram.With(labels).Set(utils.NormalizeUint64(vramUsage.FreeVisibleVRAM))
+		ms.set(ga.m.gpuTotalVisibleVram, labels, utils.NormalizeUint64(vramUsage.TotalVisibleVRAM))
+		ms.set(ga.m.gpuUsedVisibleVram, labels, utils.NormalizeUint64(vramUsage.UsedVisibleVRAM))
+		ms.set(ga.m.gpuFreeVisibleVram, labels, utils.NormalizeUint64(vramUsage.FreeVisibleVRAM))
 
-		ga.m.gpuTotalGTT.With(labels).Set(utils.NormalizeUint64(vramUsage.TotalGTT))
-		ga.m.gpuUsedGTT.With(labels).Set(utils.NormalizeUint64(vramUsage.UsedGTT))
-		ga.m.gpuFreeGTT.With(labels).Set(utils.NormalizeUint64(vramUsage.FreeGTT))
+		ms.set(ga.m.gpuTotalGTT, labels, utils.NormalizeUint64(vramUsage.TotalGTT))
+		ms.set(ga.m.gpuUsedGTT, labels, utils.NormalizeUint64(vramUsage.UsedGTT))
+		ms.set(ga.m.gpuFreeGTT, labels, utils.NormalizeUint64(vramUsage.FreeGTT))
 	}
 	vramFound := false
 	if vramStatus != nil {
@@ -1924,27 +2261,27 @@ func (ga *GPUAgentClient) updateGPUInfoToMetrics(
 	}
 	freeVRAM = totalVRAM - usedVRAM
 	if totalVRAM != 0 {
-		ga.m.gpuTotalVram.With(labels).Set(totalVRAM)
-		ga.m.gpuUsedVram.With(labels).Set(usedVRAM)
-		ga.m.gpuFreeVram.With(labels).Set(freeVRAM)
+		ms.set(ga.m.gpuTotalVram, labels, totalVRAM)
+		ms.set(ga.m.gpuUsedVram, labels, usedVRAM)
+		ms.set(ga.m.gpuFreeVram, labels, freeVRAM)
 	}
 	xgmiStats := stats.XGMILinkStats
 	if xgmiStats != nil {
 		for j, linkStat := range xgmiStats {
 			labelsWithIndex["link_index"] = fmt.Sprintf("%v", j)
-			ga.m.gpuXgmiLinkStatsRx.With(labelsWithIndex).Set(utils.NormalizeUint64(linkStat.DataRead))
-			ga.m.gpuXgmiLinkStatsTx.With(labelsWithIndex).Set(utils.NormalizeUint64(linkStat.DataWrite))
+			ms.set(ga.m.gpuXgmiLinkStatsRx, labelsWithIndex, utils.NormalizeUint64(linkStat.DataRead))
+			ms.set(ga.m.gpuXgmiLinkStatsTx, labelsWithIndex, utils.NormalizeUint64(linkStat.DataWrite))
 		}
 		delete(labelsWithIndex, "link_index")
 	}
 	violationStats := stats.ViolationStats
 	if violationStats != nil {
-		ga.m.gpuCurrAccCtr.With(labels).Set(utils.NormalizeUint64(violationStats.CurrentAccumulatedCounter))
-		ga.m.gpuProcHRA.With(labels).Set(utils.NormalizeUint64(violationStats.ProcessorHotResidencyAccumulated))
-		ga.m.gpuPPTRA.With(labels).Set(utils.NormalizeUint64(violationStats.PPTResidencyAccumulated))
-		ga.m.gpuSTRA.With(labels).Set(utils.NormalizeUint64(violationStats.SocketThermalResidencyAccumulated))
-		ga.m.gpuVRTRA.With(labels).Set(utils.NormalizeUint64(violationStats.VRThermalResidencyAccumulated))
-		ga.m.gpuHBMTRA.With(labels).Set(utils.NormalizeUint64(violationStats.HBMThermalResidencyAccumulated))
+		ms.set(ga.m.gpuCurrAccCtr, labels, utils.NormalizeUint64(violationStats.CurrentAccumulatedCounter))
+		ms.set(ga.m.gpuProcHRA, labels, utils.NormalizeUint64(violationStats.ProcessorHotResidencyAccumulated))
+		ms.set(ga.m.gpuPPTRA, labels, utils.NormalizeUint64(violationStats.PPTResidencyAccumulated))
+		ms.set(ga.m.gpuSTRA, labels, utils.NormalizeUint64(violationStats.SocketThermalResidencyAccumulated))
+		ms.set(ga.m.gpuVRTRA, labels, utils.NormalizeUint64(violationStats.VRThermalResidencyAccumulated))
+		ms.set(ga.m.gpuHBMTRA, labels, utils.NormalizeUint64(violationStats.HBMThermalResidencyAccumulated))
 	}
 
 	// populate prof metrics if available
@@ -1957,104 +2294,104 @@ func (ga *GPUAgentClient) updateGPUInfoToMetrics(
 	for mkey, value := range profMetrics {
 		switch mkey {
 		case "GRBM_GUI_ACTIVE":
-			ga.m.gpuGrbmGuiActivity.With(labels).Set(value)
-			ga.m.gpuOccElapsed.With(labels).Set(value)
+			ms.set(ga.m.gpuGrbmGuiActivity, labels, value)
+			ms.set(ga.m.gpuOccElapsed, labels, value)
 		case "SQ_WAVES":
-			ga.m.gpuSqWaves.With(labels).Set(value)
+			ms.set(ga.m.gpuSqWaves, labels, value)
 		case "GRBM_COUNT":
-			ga.m.gpuGrbmCount.With(labels).Set(value)
+			ms.set(ga.m.gpuGrbmCount, labels, value)
 		case "GPU_UTIL":
-			ga.m.gpuGPUUtil.With(labels).Set(value)
+			ms.set(ga.m.gpuGPUUtil, labels, value)
 		case "FETCH_SIZE":
-			ga.m.gpuFetchSize.With(labels).Set(value)
+			ms.set(ga.m.gpuFetchSize, labels, value)
 		case "WRITE_SIZE":
-			ga.m.gpuWriteSize.With(labels).Set(value)
+			ms.set(ga.m.gpuWriteSize, labels, value)
 		case "TOTAL_16_OPS":
-			ga.m.gpuTotal16Ops.With(labels).Set(value)
+			ms.set(ga.m.gpuTotal16Ops, labels, value)
 		case "TOTAL_32_OPS":
-			ga.m.gpuTotal32Ops.With(labels).Set(value)
+			ms.set(ga.m.gpuTotal32Ops, labels, value)
 		case "TOTAL_64_OPS":
-			ga.m.gpuTotal64Ops.With(labels).Set(value)
+			ms.set(ga.m.gpuTotal64Ops, labels, value)
 		case "CPC_CPC_STAT_BUSY":
-			ga.m.gpuCpcStatBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcStatBusy, labels, value)
 		case "CPC_CPC_STAT_IDLE":
-			ga.m.gpuCpcStatIdle.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcStatIdle, labels, value)
 		case "CPC_CPC_STAT_STALL":
-			ga.m.gpuCpcStatStall.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcStatStall, labels, value)
 		case "CPC_CPC_TCIU_BUSY":
-			ga.m.gpuCpcTciuBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcTciuBusy, labels, value)
 		case "CPC_CPC_TCIU_IDLE":
-			ga.m.gpuCpcTciuIdle.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcTciuIdle, labels, value)
 		case "CPC_CPC_UTCL2IU_BUSY":
-			ga.m.gpuCpcUtcl2iuBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcUtcl2iuBusy, labels, value)
 		case "CPC_CPC_UTCL2IU_IDLE":
-			ga.m.gpuCpcUtcl2iuIdle.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcUtcl2iuIdle, labels, value)
 		case "CPC_CPC_UTCL2IU_STALL":
-			ga.m.gpuCpcUtcl2iuStall.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcUtcl2iuStall, labels, value)
 		case "CPC_ME1_BUSY_FOR_PACKET_DECODE":
-			ga.m.gpuCpcME1BusyForPacketDecode.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcME1BusyForPacketDecode, labels, value)
 		case "CPC_ME1_DC0_SPI_BUSY":
-			ga.m.gpuCpcME1Dc0SpiBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcME1Dc0SpiBusy, labels, value)
 		case "CPC_UTCL1_STALL_ON_TRANSLATION":
-			ga.m.gpuCpcUtcl1StallOnTranslation.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcUtcl1StallOnTranslation, labels, value)
 		case "CPC_ALWAYS_COUNT":
-			ga.m.gpuCpcAlwaysCount.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcAlwaysCount, labels, value)
 		case "CPC_ADC_VALID_CHUNK_NOT_AVAIL":
-			ga.m.gpuCpcAdcValidChunkNotAvail.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcAdcValidChunkNotAvail, labels, value)
 		case "CPC_ADC_DISPATCH_ALLOC_DONE":
-			ga.m.gpuCpcAdcDispatchAllocDone.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcAdcDispatchAllocDone, labels, value)
 		case "CPC_ADC_VALID_CHUNK_END":
-			ga.m.gpuCpcAdcValidChunkEnd.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcAdcValidChunkEnd, labels, value)
 		case "CPC_SYNC_FIFO_FULL_LEVEL":
-			ga.m.gpuCpcSynFifoFullLevel.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcSynFifoFullLevel, labels, value)
 		case "CPC_SYNC_FIFO_FULL":
-			ga.m.gpuCpcSynFifoFull.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcSynFifoFull, labels, value)
 		case "CPC_GD_BUSY":
-			ga.m.gpuCpcGdBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcGdBusy, labels, value)
 		case "CPC_TG_SEND":
-			ga.m.gpuCpcTgSend.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcTgSend, labels, value)
 		case "CPC_WALK_NEXT_CHUNK":
-			ga.m.gpuCpcWalkNextChunk.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcWalkNextChunk, labels, value)
 		case "CPC_STALLED_BY_SE0_SPI":
-			ga.m.gpuCpcStalledBySe0Spi.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcStalledBySe0Spi, labels, value)
 		case "CPC_STALLED_BY_SE1_SPI":
-			ga.m.gpuCpcStalledBySe1Spi.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcStalledBySe1Spi, labels, value)
 		case "CPC_STALLED_BY_SE2_SPI":
-			ga.m.gpuCpcStalledBySe2Spi.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcStalledBySe2Spi, labels, value)
 		case "CPC_STALLED_BY_SE3_SPI":
-			ga.m.gpuCpcStalledBySe3Spi.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcStalledBySe3Spi, labels, value)
 		case "CPC_LTE_ALL":
-			ga.m.gpuCpcLteAll.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcLteAll, labels, value)
 		case "CPC_SYNC_WRREQ_FIFO_BUSY":
-			ga.m.gpuCpcSyncWrreqFifoBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcSyncWrreqFifoBusy, labels, value)
 		case "CPC_CANE_BUSY":
-			ga.m.gpuCpcCaneBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcCaneBusy, labels, value)
 		case "CPC_CANE_STALL":
-			ga.m.gpuCpcCaneStall.With(labels).Set(value)
+			ms.set(ga.m.gpuCpcCaneStall, labels, value)
 		case "CPF_CMP_UTCL1_STALL_ON_TRANSLATION":
-			ga.m.gpuCpfCmpUtcl1StallOnTrnsalation.With(labels).Set(value)
+			ms.set(ga.m.gpuCpfCmpUtcl1StallOnTrnsalation, labels, value)
 		case "CPF_CPF_STAT_BUSY":
-			ga.m.gpuCpfStatBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpfStatBusy, labels, value)
 		case "CPF_CPF_STAT_IDLE":
-			ga.m.gpuCpfStatIdle.With(labels).Set(value)
+			ms.set(ga.m.gpuCpfStatIdle, labels, value)
 		case "CPF_CPF_STAT_STALL":
-			ga.m.gpuCpfStatStall.With(labels).Set(value)
+			ms.set(ga.m.gpuCpfStatStall, labels, value)
 		case "CPF_CPF_TCIU_BUSY":
-			ga.m.gpuCpfStatTciuBusy.With(labels).Set(value)
+			ms.set(ga.m.gpuCpfStatTciuBusy, labels, value)
 		case "CPF_CPF_TCIU_IDLE":
-			ga.m.gpuCpfStatTciuIdle.With(labels).Set(value)
+			ms.set(ga.m.gpuCpfStatTciuIdle, labels, value)
 		case "CPF_CPF_TCIU_STALL":
-			ga.m.gpuCpfStatTciuStall.With(labels).Set(value)
+			ms.set(ga.m.gpuCpfStatTciuStall, labels, value)
 		case "OccupancyPercent":
-			ga.m.gpuOccPercent.With(labels).Set(value)
+			ms.set(ga.m.gpuOccPercent, labels, value)
 		case "MfmaUtil":
-			ga.m.gpuTensorActivePercent.With(labels).Set(value)
+			ms.set(ga.m.gpuTensorActivePercent, labels, value)
 		case "ValuPipeIssueUtil":
-			ga.m.gpuValuPipeIssueUtil.With(labels).Set(value)
+			ms.set(ga.m.gpuValuPipeIssueUtil, labels, value)
 		case "VALUBusy":
-			ga.m.gpuSMActive.With(labels).Set(value)
+			ms.set(ga.m.gpuSMActive, labels, value)
 		case "MeanOccupancyPerActiveCU":
-			ga.m.gpuOccPerActiveCU.With(labels).Set(value)
+			ms.set(ga.m.gpuOccPerActiveCU, labels, value)
 		}
 	}
 }
@@ -0,0 +1,137 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	k8sclient "github.com/ROCm/device-metrics-exporter/pkg/client"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+)
+
+// processLabels are the labels of the GPU_PROCESS_* fields on top of the
+// gpu labels
+var processLabels = []string{"pid", "process_name", "uid", "container_id"}
+
+// processWorkloadLabels are the gpu labels set to the workload of the
+// process, which isn't always the one the gpu is allocated to on shared
+// nodes
+var processWorkloadLabels = []string{
+	strings.ToLower(exportermetrics.GPUMetricLabel_POD.String()),
+	strings.ToLower(exportermetrics.GPUMetricLabel_NAMESPACE.String()),
+	strings.ToLower(exportermetrics.GPUMetricLabel_CONTAINER.String()),
+	strings.ToLower(exportermetrics.GPUMetricLabel_JOB_ID.String()),
+	strings.ToLower(exportermetrics.GPUMetricLabel_JOB_USER.String()),
+	strings.ToLower(exportermetrics.GPUMetricLabel_JOB_PARTITION.String()),
+}
+
+func (ga *GPUAgentClient) initProcessMetrics(config *exportermetrics.GPUMetricConfig) {
+	pm := config.GetProcessMetrics()
+	ga.procReader = fsysdevice.NewKFDProcessReader(pm.GetProcfsRoot(), pm.GetSysfsRoot())
+}
+
+// setExportedLabel sets the label only if it is exported
+func setExportedLabel(labels map[string]string, label, value string) {
+	if _, ok := labels[label]; ok {
+		labels[label] = value
+	}
+}
+
+// setProcessPodLabels sets the pod labels of the process container, the
+// container is left empty when only the pod is known
+func setProcessPodLabels(labels map[string]string, p *fsysdevice.KFDProcess, containers []k8sclient.PodContainer) {
+	for _, c := range containers {
+		container := ""
+		if p.ContainerID != "" && c.ContainerID == p.ContainerID {
+			container = c.Container
+		} else if p.PodUID == "" || c.PodUID != p.PodUID {
+			continue
+		}
+		setExportedLabel(labels, strings.ToLower(exportermetrics.GPUMetricLabel_POD.String()), c.PodName)
+		setExportedLabel(labels, strings.ToLower(exportermetrics.GPUMetricLabel_NAMESPACE.String()), c.Namespace)
+		setExportedLabel(labels, strings.ToLower(exportermetrics.GPUMetricLabel_CONTAINER.String()), container)
+		if container != "" {
+			return
+		}
+	}
+}
+
+// setProcessJobLabels sets the slurm job labels, the user and partition
+// are known for the jobs the exporter tracks only
+func setProcessJobLabels(labels map[string]string, jobID string, wls map[string]scheduler.Workload) {
+	setExportedLabel(labels, strings.ToLower(exportermetrics.GPUMetricLabel_JOB_ID.String()), jobID)
+	for _, wl := range wls {
+		if job, ok := wl.Info.(scheduler.JobInfo); ok && job.Id == jobID {
+			setExportedLabel(labels, strings.ToLower(exportermetrics.GPUMetricLabel_JOB_USER.String()), job.User)
+			setExportedLabel(labels, strings.ToLower(exportermetrics.GPUMetricLabel_JOB_PARTITION.String()), job.Partition)
+			return
+		}
+	}
+}
+
+// updateProcessMetrics exports the usage of the gpu kfd processes with the
+// container, pod or slurm job each process runs in
+func (ga *GPUAgentClient) updateProcessMetrics(ms *metricSet, labels map[string]string, gpu *amdgpu.GPU,
+	wls map[string]scheduler.Workload) {
+	pids := gpu.Status.GetKFDProcessId()
+	if len(pids) == 0 || ga.procReader == nil {
+		return
+	}
+	kfdGPUID, err := ga.procReader.GetKFDGPUID(gpu.Status.NodeId)
+	if err != nil {
+		logger.Log.Printf("gpu %v process metrics skipped, %v", getGPUInstanceID(gpu), err)
+		return
+	}
+	var containers []k8sclient.PodContainer
+	containersListed := false
+	for _, pid := range pids {
+		p, err := ga.procReader.GetProcess(pid, kfdGPUID)
+		if err != nil {
+			// exited since the gpu was read
+			continue
+		}
+		plabels := maps.Clone(labels)
+		plabels["pid"] = fmt.Sprintf("%v", p.Pid)
+		plabels["process_name"] = p.Name
+		plabels["uid"] = p.Uid
+		plabels["container_id"] = p.ContainerID
+		for _, label := range processWorkloadLabels {
+			setExportedLabel(plabels, label, "")
+		}
+		if (p.ContainerID != "" || p.PodUID != "") && ga.k8sApiClient != nil {
+			if !containersListed {
+				containersListed = true
+				if containers, err = ga.k8sApiClient.ListPodContainers(); err != nil {
+					logger.Log.Printf("ListPodContainers failed with err : %v", err)
+				}
+			}
+			setProcessPodLabels(plabels, p, containers)
+		}
+		if p.JobID != "" {
+			setProcessJobLabels(plabels, p.JobID, wls)
+		}
+		ms.set(ga.m.gpuProcessUsedVRAM, plabels, p.UsedVRAM)
+		ms.set(ga.m.gpuProcessSDMAUsage, plabels, p.SDMAUsage)
+		ms.set(ga.m.gpuProcessCUOccupancy, plabels, p.CUOccupancy)
+	}
+}
@@ -0,0 +1,193 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+// modelProfile is a GPUConfig.ModelProfiles entry, nil settings fall back
+// to the GPUConfig ones
+type modelProfile struct {
+	name       string
+	fields     map[string]bool // all upper case keys
+	labels     map[string]bool // includes the mandatory labels
+	thresholds *exportermetrics.GPUHealthThresholds
+}
+
+var (
+	modelProfiles   map[string]*modelProfile // lower case profile keys
+	defaultFieldMap map[string]bool          // GPUConfig fields, nil if no profile adds any
+	defaultLabelMap map[string]bool          // GPUConfig labels, nil if no profile adds any
+)
+
+func initModelProfiles(config *exportermetrics.GPUMetricConfig) {
+	profiles := make(map[string]*modelProfile)
+	for key, p := range config.GetModelProfiles() {
+		if p == nil {
+			continue
+		}
+		mp := &modelProfile{
+			name:       key,
+			thresholds: p.GetHealthThresholds(),
+		}
+		if len(p.GetFields()) != 0 {
+			mp.fields = make(map[string]bool)
+			for _, name := range p.GetFields() {
+				name = strings.ToUpper(name)
+				if _, ok := exportermetrics.GPUMetricField_value[name]; ok {
+					mp.fields[name] = true
+				}
+			}
+		}
+		if len(p.GetLabels()) != 0 {
+			mp.labels = make(map[string]bool)
+			for _, name := range mandatoryLables {
+				mp.labels[name] = true
+			}
+			for _, name := range p.GetLabels() {
+				name = strings.ToUpper(name)
+				if _, ok := exportermetrics.GPUMetricLabel_value[name]; ok {
+					mp.labels[name] = true
+				}
+			}
+		}
+		profiles[strings.ToLower(key)] = mp
+		logger.Log.Printf("gpu model profile %v, fields %v labels %v thresholds %v",
+			key, len(mp.fields), len(mp.labels), mp.thresholds != nil)
+	}
+	modelProfiles = profiles
+}
+
+// applyModelProfileFields enables the profile fields on top of the GPUConfig
+// ones, GPUs without a profile keep exporting the GPUConfig fields only
+func applyModelProfileFields() {
+	defaultFieldMap = nil
+	for _, p := range modelProfiles {
+		for name := range p.fields {
+			if exportFieldMap[name] {
+				continue
+			}
+			if defaultFieldMap == nil {
+				defaultFieldMap = copyEnabled(exportFieldMap)
+			}
+			logger.Log.Printf("%v field is enabled for gpu model profile %v", name, p.name)
+			exportFieldMap[name] = true
+		}
+	}
+}
+
+// applyModelProfileLabels adds the profile labels to the exported ones,
+// they are exported empty for the GPUs of other models
+func applyModelProfileLabels() {
+	defaultLabelMap = nil
+	for _, p := range modelProfiles {
+		for name := range p.labels {
+			if exportLables[name] {
+				continue
+			}
+			if defaultLabelMap == nil {
+				defaultLabelMap = copyEnabled(exportLables)
+			}
+			logger.Log.Printf("label %v enabled for gpu model profile %v", name, p.name)
+			exportLables[name] = true
+		}
+	}
+}
+
+func copyEnabled(m map[string]bool) map[string]bool {
+	enabled := make(map[string]bool)
+	for k, v := range m {
+		if v {
+			enabled[k] = true
+		}
+	}
+	return enabled
+}
+
+// gpuModelKeys returns the keys a profile is matched with, most specific
+// first: pci device id, its model name, card model and card series
+func (ga *GPUAgentClient) gpuModelKeys(gpu *amdgpu.GPU) []string {
+	keys := []string{}
+	deviceID := ""
+	if gpu.Status.PCIeStatus != nil && ga.fsysDeviceHandler != nil {
+		// partitions share the device of their base address function 0
+		busID := utils.GetPCIeBaseAddress(strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId)) + ".0"
+		deviceID, _ = ga.fsysDeviceHandler.GetPCIDeviceID(busID)
+	}
+	cardModel := strings.ToLower(gpu.Status.CardModel)
+	if deviceID == "" && strings.HasPrefix(cardModel, "0x") {
+		deviceID = cardModel
+	}
+	if deviceID != "" {
+		keys = append(keys, deviceID)
+		if name, ok := globals.GPUDeviceIDToModelName[deviceID]; ok {
+			keys = append(keys, name)
+		}
+	}
+	return append(keys, gpu.Status.CardModel, gpu.Status.CardSeries)
+}
+
+// gpuModelProfile returns the profile matching the GPU, nil if none does
+func (ga *GPUAgentClient) gpuModelProfile(gpu *amdgpu.GPU) *modelProfile {
+	profiles := modelProfiles
+	if len(profiles) == 0 || gpu == nil || gpu.Status == nil {
+		return nil
+	}
+	for _, key := range ga.gpuModelKeys(gpu) {
+		if key == "" {
+			continue
+		}
+		if p, ok := profiles[strings.ToLower(key)]; ok {
+			return p
+		}
+	}
+	return nil
+}
+
+// exportFields returns the fields exported for the GPU, nil for all the
+// enabled ones
+func (p *modelProfile) exportFields() map[string]bool {
+	if p != nil && p.fields != nil {
+		return p.fields
+	}
+	return defaultFieldMap
+}
+
+// exportLabels returns the labels exported for the GPU, nil for all the
+// enabled ones
+func (p *modelProfile) exportLabels() map[string]bool {
+	if p != nil && p.labels != nil {
+		return p.labels
+	}
+	return defaultLabelMap
+}
+
+// healthThresholds returns the profile thresholds, the given GPUConfig ones
+// if the profile has none
+func (p *modelProfile) healthThresholds(thresholds *exportermetrics.GPUHealthThresholds) *exportermetrics.GPUHealthThresholds {
+	if p != nil && p.thresholds != nil {
+		return p.thresholds
+	}
+	return thresholds
+}
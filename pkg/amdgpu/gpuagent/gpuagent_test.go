@@ -17,8 +17,27 @@
 package gpuagent
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/mock_gen"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/metricsutil"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/restapi"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"gotest.tools/assert"
 )
 
@@ -58,3 +77,634 @@ func TestGpuAgent(t *testing.T) {
 	assert.Assert(t, len(wls) == 0, "expecting success empty list of workload on k8s and slurm")
 
 }
+
+func TestGpuAgentAPI(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	gpus, err := ga.ListGPUs()
+	assert.Assert(t, err == nil, "expecting success gpu list, %v", err)
+	assert.Equal(t, len(gpus), 2)
+	assert.Equal(t, gpus[0].SerialNumber, "mock-serial")
+	assert.Equal(t, gpus[0].Health.State, restapi.HealthUnknown)
+	stats := map[string]interface{}{}
+	assert.Assert(t, json.Unmarshal(gpus[0].Stats, &stats) == nil)
+	// 64 bit values are strings in the proto3 json mapping
+	assert.Equal(t, stats["PackagePower"], "41")
+
+	assert.Assert(t, ga.processHealthValidation() == nil, "expecting success health validation")
+	gpus, err = ga.ListGPUs()
+	assert.Assert(t, err == nil)
+	// the mocked critical event marks the gpu unhealthy
+	assert.Equal(t, gpus[0].Health.State, restapi.HealthUnhealthy)
+	assert.Equal(t, len(gpus[0].Health.Reasons), 1)
+	assert.Assert(t, strings.HasPrefix(gpus[0].Health.Reasons[0], "critical event EVENT_ID_VM_PAGE_FAULT"),
+		"unexpected reason %v", gpus[0].Health.Reasons[0])
+
+	ga.SetComputeNodeHealthState(false)
+	gpus, err = ga.ListGPUs()
+	assert.Assert(t, err == nil)
+	assert.DeepEqual(t, gpus[0].Health, restapi.Health{State: restapi.HealthUnhealthy, Reasons: []string{computeNodeUnhealthy}})
+}
+
+func TestGpuAgentHealthWatch(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	changes := [][]*metricssvc.GPUState{}
+	removals := [][]string{}
+	ga.SetHealthWatch(func(states []*metricssvc.GPUState, removed []string) {
+		// called without the agent lock held
+		_, _ = ga.GetGPUHealthStates()
+		changes = append(changes, states)
+		removals = append(removals, removed)
+	})
+
+	assert.Assert(t, ga.processHealthValidation() == nil, "expecting success health validation")
+	assert.Equal(t, len(changes), 1)
+	assert.Equal(t, len(changes[0]), len(ga.healthState))
+	assert.Equal(t, changes[0][0].ID, "0")
+
+	// unchanged states are not sent again
+	ga.Lock()
+	states := ga.cloneHealthState()
+	ga.Unlock()
+	assert.Assert(t, ga.updateNewHealthState(states) == nil)
+	assert.Equal(t, len(changes), 1)
+
+	// nor are states differing by their error counts only
+	states["0"].ErrorCounts = map[string]uint64{"GPU_ECC_UNCORRECT_SDMA": 3}
+	assert.Assert(t, ga.updateNewHealthState(states) == nil)
+	assert.Equal(t, len(changes), 1)
+
+	ga.SetComputeNodeHealthState(false)
+	assert.Equal(t, len(changes), 2)
+	for _, state := range changes[1] {
+		assert.DeepEqual(t, state.HealthReasons, []string{computeNodeUnhealthy})
+	}
+
+	// a GPU no longer reported is sent as removed
+	delete(states, "0")
+	assert.Assert(t, ga.updateNewHealthState(states) == nil)
+	assert.Equal(t, len(changes), 3)
+	assert.DeepEqual(t, removals[2], []string{"0"})
+}
+
+func TestGpuAgentHealthPoll(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	for i := 0; i < healthPollFailureLimit; i++ {
+		assert.Assert(t, ga.CheckHealthPoll() == nil, "expecting success before %v failures", healthPollFailureLimit)
+		ga.setHealthPollResult(fmt.Errorf("data pull error occured"))
+	}
+	assert.Assert(t, ga.CheckHealthPoll() != nil, "expecting failure after %v failed polls", healthPollFailureLimit)
+	ga.setHealthPollResult(nil)
+	assert.Assert(t, ga.CheckHealthPoll() == nil, "expecting success after a successful poll")
+}
+
+func TestGpuAgentCollector(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	// registers the agent as a collector and publishes the static snapshot
+	mh.InitConfig()
+
+	for i := 0; i < 2; i++ {
+		err := ga.UpdateMetricsStats()
+		assert.Assert(t, err == nil, "expecting success metrics update")
+
+		families, err := mh.GetRegistry().Gather()
+		assert.Assert(t, err == nil, "expecting consistent gather, %v", err)
+		found := map[string][]float64{}
+		for _, mf := range families {
+			for _, m := range mf.GetMetric() {
+				found[mf.GetName()] = append(found[mf.GetName()], m.GetGauge().GetValue())
+			}
+		}
+		assert.DeepEqual(t, found["gpu_package_power"], []float64{41, 41})
+		assert.DeepEqual(t, found["gpu_nodes_total"], []float64{2})
+	}
+
+	// disabled fields are not exported
+	assert.Assert(t, mh.GetRegistry().Unregister(ga), "expecting agent collector registered")
+	initFieldConfig(&exportermetrics.GPUMetricConfig{
+		Fields: []string{exportermetrics.GPUMetricField_GPU_NODES_TOTAL.String()},
+	})
+	ga.initPrometheusMetrics()
+	ga.initProfilerMetricsField()
+	assert.Assert(t, ga.initFieldRegistration() == nil, "expecting success field registration")
+	assert.Assert(t, ga.UpdateMetricsStats() == nil, "expecting success metrics update")
+	families, err := mh.GetRegistry().Gather()
+	assert.Assert(t, err == nil, "expecting consistent gather, %v", err)
+	assert.Equal(t, len(families), 1)
+	assert.Equal(t, families[0].GetName(), "gpu_nodes_total")
+}
+
+func TestCounterMetrics(t *testing.T) {
+	assert.Equal(t, counterName("gpu_energy_consumed"), "gpu_energy_consumed_total")
+	assert.Equal(t, counterName("gpu_ecc_correct_total"), "gpu_ecc_correct_total")
+	assert.Assert(t, isCumulativeField(exportermetrics.GPUMetricField_GPU_ECC_UNCORRECT_UMC.String()))
+	assert.Assert(t, !isCumulativeField(exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String()))
+
+	g := newGaugeDesc(prometheus.GaugeOpts{Name: "gpu_energy_consumed"}, []string{"gpu_id"})
+	g.enabled = true
+	labels := map[string]string{"gpu_id": "0"}
+	tracker := newCounterTracker()
+
+	// collect returns the counter sample and its created timestamp if any
+	collect := func(value float64) (*dto.Metric, int) {
+		ms := newMetricSet(tracker)
+		ms.set(g, labels, value)
+		var counter *dto.Metric
+		for key, m := range ms.metrics {
+			if key.desc == g.counter {
+				counter = &dto.Metric{}
+				assert.Assert(t, m.Write(counter) == nil)
+			}
+		}
+		tracker.prune(ms)
+		return counter, len(ms.metrics)
+	}
+
+	g.setMetricType(metricsutil.MetricTypeBoth)
+	m, count := collect(10)
+	assert.Equal(t, count, 2, "expecting both gauge and counter")
+	assert.Equal(t, m.GetCounter().GetValue(), float64(10))
+	assert.Assert(t, m.GetCounter().GetCreatedTimestamp() == nil)
+
+	m, _ = collect(20)
+	assert.Assert(t, m.GetCounter().GetCreatedTimestamp() == nil)
+
+	// value going down is a reset, series starts over
+	m, _ = collect(5)
+	assert.Equal(t, m.GetCounter().GetValue(), float64(5))
+	assert.Assert(t, m.GetCounter().GetCreatedTimestamp() != nil, "expecting created timestamp on reset")
+
+	g.setMetricType(metricsutil.MetricTypeCounter)
+	_, count = collect(6)
+	assert.Equal(t, count, 1, "expecting counter only")
+
+	g.setMetricType(metricsutil.MetricTypeGauge)
+	m, count = collect(7)
+	assert.Equal(t, count, 1, "expecting gauge only")
+	assert.Assert(t, m == nil)
+	assert.Equal(t, len(tracker.series), 0)
+
+	// a name already ending in _total is kept by the counter, which
+	// replaces the gauge of the same name
+	g = newGaugeDesc(prometheus.GaugeOpts{Name: "gpu_ecc_correct_total"}, []string{"gpu_id"})
+	g.enabled = true
+	g.setMetricType(metricsutil.MetricTypeBoth)
+	m, count = collect(8)
+	assert.Equal(t, count, 1, "expecting counter only")
+	assert.Equal(t, m.GetCounter().GetValue(), float64(8))
+}
+
+// initTestMetrics initializes the fields, labels and metrics of the agent
+// from the config
+func initTestMetrics(t *testing.T, ga *GPUAgentClient, config *exportermetrics.GPUMetricConfig) {
+	initModelProfiles(config)
+	ga.initLabelConfigs(config)
+	applyModelProfileLabels()
+	initFieldConfig(config)
+	applyModelProfileFields()
+	ga.initPrometheusMetrics()
+	ga.initProfilerMetricsField()
+	assert.Assert(t, ga.initFieldRegistration() == nil, "expecting success field registration")
+}
+
+// testSeries is a gauge series of a metric set
+type testSeries struct {
+	labels map[string]string
+	value  float64
+}
+
+// seriesOf returns the gauge series of the metric in the metric set
+func seriesOf(t *testing.T, ms *metricSet, g *gaugeDesc) []testSeries {
+	var result []testSeries
+	for key, m := range ms.metrics {
+		if key.desc != g.desc {
+			continue
+		}
+		metric := &dto.Metric{}
+		assert.Assert(t, m.Write(metric) == nil)
+		series := testSeries{labels: map[string]string{}, value: metric.GetGauge().GetValue()}
+		for _, lp := range metric.GetLabel() {
+			series.labels[lp.GetName()] = lp.GetValue()
+		}
+		result = append(result, series)
+	}
+	return result
+}
+
+func TestModelProfiles(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	config := &exportermetrics.GPUMetricConfig{
+		Fields: []string{exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String()},
+		ModelProfiles: map[string]*exportermetrics.GPUModelProfile{
+			"mi300x": {
+				Fields: []string{
+					exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String(),
+					exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE.String(),
+				},
+				Labels:           []string{exportermetrics.GPUMetricLabel_CARD_SERIES.String()},
+				HealthThresholds: &exportermetrics.GPUHealthThresholds{GPU_ECC_UNCORRECT_SDMA: 10},
+			},
+		},
+	}
+	initTestMetrics(t, ga, config)
+
+	// device id card model matches the profile through the model name
+	gpus := []*amdgpu.GPU{}
+	for i, model := range []string{"0x74a1", "0x740f"} {
+		gpus = append(gpus, &amdgpu.GPU{
+			Spec:   &amdgpu.GPUSpec{Id: []byte(fmt.Sprintf("gpu-%v", i))},
+			Status: &amdgpu.GPUStatus{Index: uint32(i), CardModel: model, CardSeries: "series-" + model},
+			Stats: &amdgpu.GPUStats{
+				PackagePower:            41,
+				Temperature:             &amdgpu.GPUTemperatureStats{EdgeTemperature: 50},
+				SDMAUncorrectableErrors: 5,
+			},
+		})
+	}
+	assert.Equal(t, ga.gpuModelProfile(gpus[0]).name, "mi300x")
+	assert.Assert(t, ga.gpuModelProfile(gpus[1]) == nil)
+
+	ms := newMetricSet(nil)
+	for _, gpu := range gpus {
+		ga.updateGPUInfoToMetrics(ms, nil, gpu, nil, nil, nil, nil)
+	}
+	edgeTemp := map[string]bool{}
+	for _, series := range seriesOf(t, ms, ga.m.gpuEdgeTemp) {
+		edgeTemp[series.labels["gpu_id"]] = true
+	}
+	cardSeries := map[string]string{}
+	for _, series := range seriesOf(t, ms, ga.m.gpuPackagePower) {
+		cardSeries[series.labels["gpu_id"]] = series.labels["card_series"]
+	}
+	assert.DeepEqual(t, edgeTemp, map[string]bool{"0": true})
+	assert.DeepEqual(t, cardSeries, map[string]string{"0": "series-0x74a1", "1": ""})
+
+	// profile thresholds apply to the matching gpu only
+	health := ga.processEccErrorMetrics(gpus, nil)
+	assert.Equal(t, health["0"].Health, strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()))
+	assert.Equal(t, health["1"].Health, strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String()))
+	// the reason doesn't carry the count
+	assert.DeepEqual(t, health["1"].HealthReasons, []string{"GPU_ECC_UNCORRECT_SDMA crossed threshold 0"})
+	assert.DeepEqual(t, health["1"].ErrorCounts, map[string]uint64{"GPU_ECC_UNCORRECT_SDMA": 5})
+}
+
+func TestStatusMetrics(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	config := &exportermetrics.GPUMetricConfig{}
+	initTestMetrics(t, ga, config)
+
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{Id: []byte("gpu-0")},
+		Status: &amdgpu.GPUStatus{
+			OperStatus:      amdgpu.GPUOperStatus_GPU_OPER_STATUS_UP,
+			CardSKU:         "M3000100",
+			DriverVersion:   "6.10.5",
+			VBIOSPartNumber: "113-M3000100-102",
+			FirmwareVersion: []*amdgpu.GPUFirmwareVersion{
+				{Firmware: "PMFW", Version: "85.121.0"},
+				{Firmware: "SDMA", Version: "19"},
+			},
+			VRAMStatus: &amdgpu.GPUVRAMStatus{
+				Type:   amdgpu.VRAMType_VRAM_TYPE_HBM3,
+				Vendor: amdgpu.VRAMVendor_VRAM_VENDOR_HYNIX,
+			},
+			ThrottlingStatus: amdgpu.GPUThrottlingStatus_GPU_THROTTLING_STATUS_ON,
+			XGMIStatus: &amdgpu.GPUXGMIStatus{
+				ErrorStatus: amdgpu.GPUXGMIErrorStatus_GPU_XGMI_STATUS_MULTIPLE_ERROR,
+				Width:       16,
+				Speed:       32,
+			},
+			PCIeStatus: &amdgpu.GPUPCIeStatus{
+				SlotType: amdgpu.PCIeSlotType_PCIE_SLOT_TYPE_OAM,
+				Version:  5,
+				Width:    8,
+				MaxWidth: 16,
+			},
+		},
+		Stats: &amdgpu.GPUStats{
+			FanSpeed:                  1200,
+			GFXActivityAccumulated:    300,
+			MemoryActivityAccumulated: 100,
+		},
+	}
+	expected := map[*gaugeDesc]float64{
+		ga.m.gpuOperStatus:       1,
+		ga.m.gpuThrottlingStatus: 1,
+		ga.m.gpuXgmiErrorStatus:  2,
+		ga.m.gpuXgmiLinkWidth:    16,
+		ga.m.gpuXgmiLinkSpeed:    32,
+		ga.m.gpuPCIeVersion:      5,
+		ga.m.gpuPCIeWidth:        8,
+		ga.m.gpuPCIeMaxWidth:     16,
+		ga.m.gpuPCIeSlotType:     1,
+		ga.m.gpuFanSpeed:         1200,
+		ga.m.gpuGFXActivityAcc:   300,
+		ga.m.gpuMemActivityAcc:   100,
+		ga.m.gpuHardwareInfo:     1,
+	}
+	ms := newMetricSet(nil)
+	ga.updateGPUInfoToMetrics(ms, nil, gpu, nil, nil, nil, nil)
+	for g, value := range expected {
+		series := seriesOf(t, ms, g)
+		assert.Equal(t, len(series), 1, "%v", g.desc)
+		assert.Equal(t, series[0].value, value, "%v", g.desc)
+	}
+	assert.Equal(t, seriesOf(t, ms, ga.m.gpuPCIeSlotType)[0].labels["slot_type"], "oam")
+
+	// firmware and hardware inventory
+	firmware := map[string]string{}
+	for _, series := range seriesOf(t, ms, ga.m.gpuFirmwareInfo) {
+		assert.Equal(t, series.value, float64(1))
+		firmware[series.labels["component"]] = series.labels["version"]
+	}
+	assert.DeepEqual(t, firmware, map[string]string{"PMFW": "85.121.0", "SDMA": "19"})
+	hardware := seriesOf(t, ms, ga.m.gpuHardwareInfo)[0].labels
+	assert.Equal(t, hardware["card_sku"], "M3000100")
+	assert.Equal(t, hardware["vbios_part_number"], "113-M3000100-102")
+	assert.Equal(t, hardware["vram_type"], "hbm3")
+	assert.Equal(t, hardware["vram_vendor"], "hynix")
+	assert.Equal(t, hardware["driver_version"], "6.10.5")
+
+	// unknown states are not exported
+	gpu.Status.OperStatus = amdgpu.GPUOperStatus_GPU_OPER_STATUS_NONE
+	ms = newMetricSet(nil)
+	ga.updateGPUInfoToMetrics(ms, nil, gpu, nil, nil, nil, nil)
+	assert.Equal(t, len(seriesOf(t, ms, ga.m.gpuOperStatus)), 0, "expecting no oper status")
+}
+
+// badPageStream replays the bad page responses
+type badPageStream struct {
+	grpc.ClientStream
+	resps []*amdgpu.GPUBadPageGetResponse
+}
+
+func (s *badPageStream) Recv() (*amdgpu.GPUBadPageGetResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+	return resp, nil
+}
+
+func TestBadPages(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	config := &exportermetrics.GPUMetricConfig{
+		ModelProfiles: map[string]*exportermetrics.GPUModelProfile{
+			"mi300x": {HealthThresholds: &exportermetrics.GPUHealthThresholds{GPU_BAD_PAGES: 2}},
+		},
+	}
+	initTestMetrics(t, ga, config)
+
+	gpus := []*amdgpu.GPU{}
+	for i, model := range []string{"0x74a1", ""} {
+		gpus = append(gpus, &amdgpu.GPU{
+			Spec:   &amdgpu.GPUSpec{Id: uuid.Must(uuid.NewV4()).Bytes()},
+			Status: &amdgpu.GPUStatus{Index: uint32(i), CardModel: model},
+			Stats:  &amdgpu.GPUStats{},
+		})
+	}
+	record := func(gpu *amdgpu.GPU, status amdgpu.GPUPageStatus) *amdgpu.GPUBadPageRecord {
+		return &amdgpu.GPUBadPageRecord{GPU: gpu.Spec.Id, PageStatus: status}
+	}
+	stream := &badPageStream{resps: []*amdgpu.GPUBadPageGetResponse{
+		{Record: []*amdgpu.GPUBadPageRecord{
+			record(gpus[0], amdgpu.GPUPageStatus_GPU_PAGE_STATUS_RESERVED),
+			record(gpus[0], amdgpu.GPUPageStatus_GPU_PAGE_STATUS_PENDING),
+		}},
+		{Record: []*amdgpu.GPUBadPageRecord{
+			record(gpus[0], amdgpu.GPUPageStatus_GPU_PAGE_STATUS_RESERVED),
+			record(gpus[1], amdgpu.GPUPageStatus_GPU_PAGE_STATUS_UNRESERVABLE),
+		}},
+	}}
+	debugMockCl := mock_gen.NewMockDebugGPUSvcClient(mockCtl)
+	// the stream is read once, later lookups are served from the cache
+	debugMockCl.EXPECT().GPUBadPageGet(gomock.Any(), gomock.Any()).Return(stream, nil).Times(1)
+	ga.debugclient = debugMockCl
+
+	// pages of all states count against the threshold, the default 0
+	// disables the check
+	health := ga.processEccErrorMetrics(gpus, nil)
+	assert.Equal(t, health["0"].Health, strings.ToLower(metricssvc.GPUHealth_UNHEALTHY.String()))
+	assert.Equal(t, health["1"].Health, strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()))
+
+	badPages := ga.getBadPages()
+	ms := newMetricSet(nil)
+	for _, gpu := range gpus {
+		ga.updateGPUInfoToMetrics(ms, nil, gpu, nil, nil, nil, badPages)
+	}
+	counts := map[string]float64{}
+	for _, series := range seriesOf(t, ms, ga.m.gpuBadPages) {
+		counts[series.labels["gpu_id"]+"/"+series.labels["page_status"]] = series.value
+	}
+	assert.DeepEqual(t, counts, map[string]float64{
+		"0/reserved": 2, "0/pending": 1, "0/unreservable": 0,
+		"1/reserved": 0, "1/pending": 0, "1/unreservable": 1,
+	})
+
+	// a failed refresh keeps the last counts and is retried sooner
+	ga.bpCache.nextRefresh = time.Time{}
+	debugMockCl.EXPECT().GPUBadPageGet(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("unavailable"))
+	assert.DeepEqual(t, ga.getBadPages(), badPages)
+	assert.Assert(t, ga.bpCache.nextRefresh.Before(time.Now().Add(badPageRetryInterval+time.Second)))
+
+	// lookups during a refresh are served the last counts
+	ga.bpCache.nextRefresh = time.Time{}
+	ga.bpCache.refreshing = true
+	assert.DeepEqual(t, ga.getBadPages(), badPages)
+	ga.bpCache.refreshing = false
+
+	// the stream isn't read when the field isn't exported and no threshold
+	// is set
+	initModelProfiles(&exportermetrics.GPUMetricConfig{})
+	exportFieldMap[exportermetrics.GPUMetricField_GPU_BAD_PAGES.String()] = false
+	assert.Assert(t, ga.getBadPages() == nil)
+	health = ga.processEccErrorMetrics(gpus, nil)
+	assert.Equal(t, health["0"].Health, strings.ToLower(metricssvc.GPUHealth_HEALTHY.String()))
+}
+
+func TestProcessMetrics(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	config := &exportermetrics.GPUMetricConfig{}
+	initTestMetrics(t, ga, config)
+
+	// fake procfs and sysfs tree
+	root := t.TempDir()
+	containerID := strings.Repeat("ab", 32)
+	for path, content := range map[string]string{
+		"sys/class/kfd/kfd/topology/nodes/2/gpu_id":           "48153",
+		"sys/class/kfd/kfd/proc/100/vram_48153":               "1048576",
+		"sys/class/kfd/kfd/proc/100/sdma_48153":               "1500",
+		"sys/class/kfd/kfd/proc/100/stats_48153/cu_occupancy": "38",
+		"sys/class/kfd/kfd/proc/200/vram_48153":               "2097152",
+		"proc/100/comm":                                       "python3",
+		"proc/100/status":                                     "Uid:\t1000\t1000\t1000\t1000\n",
+		"proc/100/cgroup":                                     "0::/system.slice/docker-" + containerID + ".scope\n",
+		"proc/200/comm":                                       "train",
+		"proc/200/status":                                     "Uid:\t1001\t1001\t1001\t1001\n",
+		"proc/200/cgroup":                                     "0::/system.slice/slurmstepd.scope/job_4242/step_0/user/task_0\n",
+	} {
+		path = filepath.Join(root, path)
+		assert.Assert(t, os.MkdirAll(filepath.Dir(path), 0755) == nil)
+		assert.Assert(t, os.WriteFile(path, []byte(content), 0644) == nil)
+	}
+	ga.initProcessMetrics(&exportermetrics.GPUMetricConfig{
+		ProcessMetrics: &exportermetrics.ProcessMetricsConfig{
+			ProcfsRoot: filepath.Join(root, "proc"),
+			SysfsRoot:  filepath.Join(root, "sys"),
+		},
+	})
+
+	// the gpu is allocated to another job, process 300 exited
+	gpu := &amdgpu.GPU{
+		Spec:   &amdgpu.GPUSpec{Id: []byte("gpu-0")},
+		Status: &amdgpu.GPUStatus{NodeId: 2, KFDProcessId: []uint32{100, 200, 300}},
+		Stats:  &amdgpu.GPUStats{},
+	}
+	wls := map[string]scheduler.Workload{
+		"0": {Type: scheduler.Slurm, Info: scheduler.JobInfo{Id: "17", User: "bob", Partition: "gpu"}},
+		"1": {Type: scheduler.Slurm, Info: scheduler.JobInfo{Id: "4242", User: "alice", Partition: "shared"}},
+	}
+	ms := newMetricSet(nil)
+	ga.updateGPUInfoToMetrics(ms, wls, gpu, nil, nil, nil, nil)
+	processes := map[string]map[string]string{}
+	vram := map[string]float64{}
+	for _, series := range seriesOf(t, ms, ga.m.gpuProcessUsedVRAM) {
+		processes[series.labels["pid"]] = series.labels
+		vram[series.labels["pid"]] = series.value
+	}
+	assert.DeepEqual(t, vram, map[string]float64{"100": 1, "200": 2})
+	assert.Equal(t, processes["100"]["process_name"], "python3")
+	assert.Equal(t, processes["100"]["uid"], "1000")
+	assert.Equal(t, processes["100"]["container_id"], containerID)
+	assert.Equal(t, processes["100"]["job_id"], "")
+	assert.Equal(t, processes["200"]["job_id"], "4242")
+	assert.Equal(t, processes["200"]["job_user"], "alice")
+	assert.Equal(t, processes["200"]["job_partition"], "shared")
+}
+
+func TestDerivedMetrics(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	// opt-in fields aren't part of the default all fields
+	config := &exportermetrics.GPUMetricConfig{}
+	initTestMetrics(t, ga, config)
+	assert.Assert(t, exportFieldMap[exportermetrics.GPUMetricField_GPU_POWER_USAGE.String()])
+	assert.Assert(t, !exportFieldMap[exportermetrics.GPUMetricField_GPU_DERIVED_POWER.String()])
+
+	config.Fields = []string{
+		exportermetrics.GPUMetricField_GPU_DERIVED_POWER.String(),
+		exportermetrics.GPUMetricField_GPU_DERIVED_PCIE_UTILIZATION.String(),
+		exportermetrics.GPUMetricField_GPU_DERIVED_XGMI_UTILIZATION.String(),
+		exportermetrics.GPUMetricField_GPU_DERIVED_ECC_CORRECT_RATE.String(),
+		exportermetrics.GPUMetricField_GPU_DERIVED_PCIE_REPLAY_RATE.String(),
+	}
+	initTestMetrics(t, ga, config)
+
+	id := uuid.Must(uuid.NewV4()).Bytes()
+	sample := func(index uint32, energy float64, eccCorrect, replays, xgmiRead, xgmiWrite uint64) *amdgpu.GPU {
+		return &amdgpu.GPU{
+			Spec: &amdgpu.GPUSpec{Id: id},
+			Status: &amdgpu.GPUStatus{
+				Index:      index,
+				PCIeStatus: &amdgpu.GPUPCIeStatus{MaxSpeed: 32, MaxWidth: 16, Bandwidth: 16000},
+				XGMIStatus: &amdgpu.GPUXGMIStatus{Speed: 50},
+			},
+			Stats: &amdgpu.GPUStats{
+				EnergyConsumed:         energy,
+				TotalCorrectableErrors: eccCorrect,
+				PCIeStats:              &amdgpu.GPUPCIeStats{ReplayCount: replays},
+				XGMILinkStats:          []*amdgpu.GPUXGMILinkStats{{DataRead: xgmiRead, DataWrite: xgmiWrite}},
+			},
+		}
+	}
+	values := func(gpu *amdgpu.GPU) map[string]float64 {
+		ms := newMetricSet(nil)
+		ga.updateGPUInfoToMetrics(ms, nil, gpu, nil, nil, nil, nil)
+		result := map[string]float64{}
+		for name, g := range map[string]*gaugeDesc{
+			"power":  ga.m.gpuDerivedPower,
+			"pcie":   ga.m.gpuDerivedPCIeUtilization,
+			"xgmi":   ga.m.gpuDerivedXgmiUtilization,
+			"ecc":    ga.m.gpuDerivedEccCorrectRate,
+			"replay": ga.m.gpuDerivedPCIeReplayRate,
+		} {
+			for _, series := range seriesOf(t, ms, g) {
+				result[name] = series.value
+			}
+		}
+		return result
+	}
+
+	// the first sample only has the pcie utilization, 16000 MB/s of 64000
+	start := time.Now()
+	ga.derived.observe([]*amdgpu.GPU{sample(0, 1e6, 10, 5, 0, 0)}, start)
+	assert.DeepEqual(t, values(sample(0, 1e6, 10, 5, 0, 0)), map[string]float64{"pcie": 25})
+
+	// 300 J, 2 errors, 1 replay and 5 GB read in 10s
+	gpu := sample(0, 301e6, 12, 6, 5e6, 1e6)
+	ga.derived.observe([]*amdgpu.GPU{gpu}, start.Add(10*time.Second))
+	expected := map[string]float64{"pcie": 25, "power": 30, "ecc": 12, "replay": 6, "xgmi": 1}
+	assert.DeepEqual(t, values(gpu), expected)
+
+	// a cached read keeps the rates
+	ga.derived.observe([]*amdgpu.GPU{gpu}, start.Add(10*time.Second))
+	assert.DeepEqual(t, values(gpu), expected)
+
+	// counter resets skip the reset counters only
+	gpu = sample(0, 1e6, 14, 7, 5e6, 1e6)
+	ga.derived.observe([]*amdgpu.GPU{gpu}, start.Add(20*time.Second))
+	assert.DeepEqual(t, values(gpu), map[string]float64{"pcie": 25, "ecc": 12, "replay": 6, "xgmi": 0})
+
+	// a gpu re-enumerated with another index starts over
+	gpu = sample(1, 2e6, 14, 7, 5e6, 1e6)
+	ga.derived.observe([]*amdgpu.GPU{gpu}, start.Add(30*time.Second))
+	assert.DeepEqual(t, values(gpu), map[string]float64{"pcie": 25})
+
+	// removed gpus are dropped
+	ga.derived.observe(nil, start.Add(40*time.Second))
+	assert.Assert(t, ga.derived.get(gpu) == nil)
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
 )
 
 const (
@@ -71,12 +72,14 @@ func (rpc *ROCProfilerClient) cacheMetrics() (*amdgpu.GpuProfiler, error) {
 	// If cache is fresh, return it
 	if time.Since(rpc.pCache.cacheLastRead) < cachedTimer && rpc.pCache.cachedMetrics != nil {
 		rpc.pCache.RUnlock()
+		selfmetrics.CacheHit(selfmetrics.CacheProfiler)
 		logger.Log.Printf("returning metrics from cache")
 		return rpc.pCache.cachedMetrics, nil
 	}
 	rpc.pCache.RUnlock()
 
 	// Otherwise, fetch new metrics and update cache
+	selfmetrics.CacheMiss(selfmetrics.CacheProfiler)
 	metrics, err := rpc.getMetrics()
 	rpc.pCache.Lock()
 	rpc.pCache.cacheLastRead = time.Now()
@@ -106,8 +109,10 @@ func (rpc *ROCProfilerClient) getMetrics() (*amdgpu.GpuProfiler, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), rocprofilerTimeout*time.Second)
 	defer cancel()
 
+	start := time.Now()
 	cmd := exec.CommandContext(ctx, "/bin/bash", "-c", rpc.cmd)
 	gpuMetrics, err := cmd.Output()
+	selfmetrics.ObserveProfilerExec(start, err)
 	if ctx.Err() == context.DeadlineExceeded {
 		logger.Log.Printf("command timed out after 15s: %v", rpc.cmd)
 		return nil, ctx.Err()
@@ -23,9 +23,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -35,6 +39,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/auth"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
 	//
@@ -64,6 +69,9 @@ type K8sClient struct {
 	started      bool
 	nodeInformer cache.SharedIndexInformer
 	podInformer  cache.SharedIndexInformer
+	synced       atomic.Bool // informer caches synced
+	// called when the node is added or its labels change
+	nodeLabelsHandler func(map[string]string)
 }
 
 func NewClient(ctx context.Context, nodeName string) (*K8sClient, error) {
@@ -259,6 +267,7 @@ func (k *K8sClient) startWatchers() error {
 		AddFunc: func(obj interface{}) {
 			if node, ok := obj.(*v1.Node); ok {
 				logger.Log.Printf("node added with labels: %+v", node.Labels)
+				k.notifyNodeLabels(node.Labels)
 			}
 		},
 		UpdateFunc: func(oldObj, newObj interface{}) {
@@ -266,6 +275,7 @@ func (k *K8sClient) startWatchers() error {
 			newNode := newObj.(*v1.Node)
 			if !reflect.DeepEqual(oldNode.Labels, newNode.Labels) {
 				logger.Log.Printf("node updated with labels: %+v", newNode.Labels)
+				k.notifyNodeLabels(newNode.Labels)
 			}
 		},
 	})
@@ -301,6 +311,8 @@ func (k *K8sClient) startWatchers() error {
 	if !cache.WaitForCacheSync(stopCh, k.nodeInformer.HasSynced, k.podInformer.HasSynced) {
 		return errors.New("cache sync failed")
 	}
+	k.synced.Store(true)
+	defer k.synced.Store(false)
 
 	// Block until stop signal received
 	select {
@@ -309,6 +321,31 @@ func (k *K8sClient) startWatchers() error {
 	}
 }
 
+// SetNodeLabelsHandler sets the handler called when the node is added or
+// its labels change, to be set before Watch
+func (k *K8sClient) SetNodeLabelsHandler(handler func(map[string]string)) {
+	k.Lock()
+	defer k.Unlock()
+	k.nodeLabelsHandler = handler
+}
+
+func (k *K8sClient) notifyNodeLabels(labels map[string]string) {
+	k.Lock()
+	handler := k.nodeLabelsHandler
+	k.Unlock()
+	if handler != nil {
+		handler(labels)
+	}
+}
+
+// CheckCacheSync returns an error until the node and pod caches are synced
+func (k *K8sClient) CheckCacheSync() error {
+	if !k.synced.Load() {
+		return errors.New("node and pod informer caches not synced")
+	}
+	return nil
+}
+
 func (k *K8sClient) Stop() {
 	close(k.stopCh)
 }
@@ -346,6 +383,46 @@ func (k *K8sClient) GetAllPods() (map[string]map[string]string, error) {
 	return k8PodLabelsMap, nil
 }
 
+// PodContainer is a container of a pod running on the node
+type PodContainer struct {
+	PodUID    string
+	PodName   string
+	Namespace string
+	Container string
+	// without the runtime prefix, e.g. containerd://
+	ContainerID string
+}
+
+// ListPodContainers returns the started containers of the node pods, as
+// reported by the kubelet in the pod status
+func (k *K8sClient) ListPodContainers() ([]PodContainer, error) {
+	pods, err := k.ListPods()
+	if err != nil {
+		return nil, err
+	}
+	containers := []PodContainer{}
+	for _, pod := range pods {
+		statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if status.ContainerID == "" {
+				continue
+			}
+			id := status.ContainerID
+			if i := strings.Index(id, "://"); i >= 0 {
+				id = id[i+3:]
+			}
+			containers = append(containers, PodContainer{
+				PodUID:      string(pod.UID),
+				PodName:     pod.Name,
+				Namespace:   pod.Namespace,
+				Container:   status.Name,
+				ContainerID: id,
+			})
+		}
+	}
+	return containers, nil
+}
+
 func (k *K8sClient) GetNode() (*v1.Node, error) {
 	if k.nodeInformer == nil || !k.nodeInformer.HasSynced() {
 		return nil, errors.New("cache not synced or API server unavailable")
@@ -374,3 +451,49 @@ func (k *K8sClient) ListPods() ([]*v1.Pod, error) {
 	}
 	return pods, nil
 }
+
+// ReviewToken authenticates a bearer token with a TokenReview, returns the
+// user name and groups the token belongs to
+func (k *K8sClient) ReviewToken(token string) (string, []string, error) {
+	ctx, cancel := context.WithTimeout(k.ctx, 10*time.Second)
+	defer cancel()
+
+	tr := &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+	resp, err := k.clientset.AuthenticationV1().TokenReviews().Create(ctx, tr, metav1.CreateOptions{})
+	if err != nil {
+		logger.Log.Printf("token review failed, err %+v", err)
+		return "", nil, err
+	}
+	if !resp.Status.Authenticated {
+		return "", nil, fmt.Errorf("%w, %v", auth.ErrUnauthenticated, resp.Status.Error)
+	}
+	return resp.Status.User.Username, resp.Status.User.Groups, nil
+}
+
+// ReviewAccess checks with a SubjectAccessReview if the user is allowed the
+// verb on the non resource path
+func (k *K8sClient) ReviewAccess(user string, groups []string, path, verb string) (bool, error) {
+	ctx, cancel := context.WithTimeout(k.ctx, 10*time.Second)
+	defer cancel()
+
+	sar := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:   user,
+			Groups: groups,
+			NonResourceAttributes: &authzv1.NonResourceAttributes{
+				Path: path,
+				Verb: verb,
+			},
+		},
+	}
+	resp, err := k.clientset.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		logger.Log.Printf("subject access review failed for %v, err %+v", user, err)
+		return false, err
+	}
+	return resp.Status.Allowed, nil
+}
@@ -0,0 +1,318 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// reviews are cached to keep the api server and bcrypt off the scrape
+	// path, same order as kube-rbac-proxy
+	reviewCacheTTL  = 2 * time.Minute
+	reviewCacheSize = 1024
+
+	anyIdentity = "*"
+	groupPrefix = "group:"
+
+	sourceToken      = "token"
+	sourceBasic      = "basic"
+	sourceKubernetes = "kubernetes"
+)
+
+// ErrUnauthenticated is returned by ReviewToken when the token is rejected,
+// other errors are transient and not cached
+var ErrUnauthenticated = errors.New("token not authenticated")
+
+// KubeReviewer authenticates and authorizes kubernetes identities
+type KubeReviewer interface {
+	// ReviewToken returns the user name and groups of a bearer token, an
+	// error wrapping ErrUnauthenticated if the token is rejected
+	ReviewToken(token string) (string, []string, error)
+	// ReviewAccess returns true if the user is allowed the verb on the path
+	ReviewAccess(user string, groups []string, path, verb string) (bool, error)
+}
+
+// Identity of an authenticated request
+type Identity struct {
+	Name   string
+	Groups []string
+	Source string
+}
+
+type staticToken struct {
+	name  string
+	token []byte
+}
+
+type cacheEntry struct {
+	identity *Identity
+	allowed  bool
+	expiry   time.Time
+}
+
+// reviewCache caches positive and negative review results by key hash
+type reviewCache struct {
+	sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func (c *reviewCache) get(key string) (cacheEntry, bool) {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expiry) {
+		return cacheEntry{}, false
+	}
+	return e, true
+}
+
+func (c *reviewCache) set(key string, e cacheEntry) {
+	c.Lock()
+	defer c.Unlock()
+	if len(c.entries) >= reviewCacheSize {
+		now := time.Now()
+		for k, v := range c.entries {
+			if now.After(v.expiry) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= reviewCacheSize {
+			c.entries = make(map[string]cacheEntry)
+		}
+	}
+	e.expiry = time.Now().Add(reviewCacheTTL)
+	c.entries[key] = e
+}
+
+// Authenticator enforces the per route policies of the auth config
+type Authenticator struct {
+	tokens   []staticToken
+	users    map[string][]byte // user -> bcrypt hash
+	kube     KubeReviewer
+	policies []*exportermetrics.AuthPolicy // longest prefix first
+	cache    *reviewCache
+}
+
+// NewAuthenticator builds the authenticator from the config, kube can be
+// nil when not running in kubernetes
+func NewAuthenticator(conf *exportermetrics.AuthConfig, kube KubeReviewer) (*Authenticator, error) {
+	a := &Authenticator{
+		users: make(map[string][]byte),
+		cache: &reviewCache{entries: make(map[string]cacheEntry)},
+	}
+	for name, file := range conf.GetBearerTokenFiles() {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token file for %v, %v", name, err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return nil, fmt.Errorf("empty token file for %v", name)
+		}
+		a.tokens = append(a.tokens, staticToken{name: name, token: []byte(token)})
+	}
+	for user, hash := range conf.GetBasicAuthUsers() {
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, fmt.Errorf("invalid bcrypt hash for user %v, %v", user, err)
+		}
+		a.users[user] = []byte(hash)
+	}
+	if conf.GetKubernetesTokenReview() {
+		if kube == nil {
+			logger.Log.Printf("kubernetes token review configured outside kubernetes, ignored")
+		}
+		a.kube = kube
+	}
+	for _, p := range conf.GetPolicies() {
+		if !strings.HasPrefix(p.GetPathPrefix(), "/") {
+			return nil, fmt.Errorf("invalid policy path prefix %q", p.GetPathPrefix())
+		}
+		a.policies = append(a.policies, p)
+	}
+	sort.SliceStable(a.policies, func(i, j int) bool {
+		return len(a.policies[i].GetPathPrefix()) > len(a.policies[j].GetPathPrefix())
+	})
+	logger.Log.Printf("auth enabled, %v tokens, %v users, %v policies, token review %v",
+		len(a.tokens), len(a.users), len(a.policies), a.kube != nil)
+	return a, nil
+}
+
+// TokenFileDirs returns the directories of the bearer token files, watched
+// to pick up rotated tokens
+func TokenFileDirs(conf *exportermetrics.AuthConfig) []string {
+	dirs := []string{}
+	seen := map[string]bool{}
+	for _, file := range conf.GetBearerTokenFiles() {
+		dir := filepath.Dir(file)
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+func hashKey(parts ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(h[:])
+}
+
+// policyFor returns the policy of the longest prefix matching the path on
+// whole segments, /metrics covers /metrics/gpu but not /metricsfoo
+func (a *Authenticator) policyFor(path string) *exportermetrics.AuthPolicy {
+	for _, p := range a.policies {
+		prefix := strings.TrimSuffix(p.GetPathPrefix(), "/")
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return p
+		}
+	}
+	return nil
+}
+
+// authenticate returns the identity of the request, nil if there are no
+// valid credentials
+func (a *Authenticator) authenticate(r *http.Request) *Identity {
+	if user, pass, ok := r.BasicAuth(); ok {
+		hash, found := a.users[user]
+		if !found {
+			return nil
+		}
+		key := hashKey(sourceBasic, user, pass)
+		if e, ok := a.cache.get(key); ok {
+			return e.identity
+		}
+		var id *Identity
+		if bcrypt.CompareHashAndPassword(hash, []byte(pass)) == nil {
+			id = &Identity{Name: user, Source: sourceBasic}
+		}
+		a.cache.set(key, cacheEntry{identity: id})
+		return id
+	}
+
+	authz := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authz, "Bearer ") {
+		return nil
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(authz, "Bearer "))
+	if token == "" {
+		return nil
+	}
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(t.token, []byte(token)) == 1 {
+			return &Identity{Name: t.name, Source: sourceToken}
+		}
+	}
+	if a.kube == nil {
+		return nil
+	}
+	key := hashKey(sourceKubernetes, token)
+	if e, ok := a.cache.get(key); ok {
+		return e.identity
+	}
+	var id *Identity
+	user, groups, err := a.kube.ReviewToken(token)
+	if err == nil {
+		id = &Identity{Name: user, Groups: groups, Source: sourceKubernetes}
+	} else if errors.Is(err, ErrUnauthenticated) {
+		logger.Log.Printf("token review rejected, %v", err)
+	} else {
+		// don't cache api errors
+		logger.Log.Printf("token review failed, %v", err)
+		return nil
+	}
+	a.cache.set(key, cacheEntry{identity: id})
+	return id
+}
+
+// authorize checks the identity against the route policy
+func (a *Authenticator) authorize(p *exportermetrics.AuthPolicy, id *Identity, path string) bool {
+	for _, allowed := range p.GetIdentities() {
+		if allowed == anyIdentity || allowed == id.Name {
+			return true
+		}
+		if strings.HasPrefix(allowed, groupPrefix) {
+			for _, g := range id.Groups {
+				if g == strings.TrimPrefix(allowed, groupPrefix) {
+					return true
+				}
+			}
+		}
+	}
+	if !p.GetSubjectAccessReview() || id.Source != sourceKubernetes || a.kube == nil {
+		return false
+	}
+	key := hashKey("sar", id.Name, strings.Join(id.Groups, ","), path)
+	if e, ok := a.cache.get(key); ok {
+		return e.allowed
+	}
+	allowed, err := a.kube.ReviewAccess(id.Name, id.Groups, path, "get")
+	if err != nil {
+		// don't cache api errors
+		return false
+	}
+	a.cache.set(key, cacheEntry{allowed: allowed})
+	return allowed
+}
+
+func (a *Authenticator) challenge(w http.ResponseWriter) {
+	if len(a.users) != 0 {
+		w.Header().Set("WWW-Authenticate", `Basic realm="amd-metrics-exporter"`)
+	} else {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+}
+
+// Middleware authenticates and authorizes every request as per the policy
+// of its route, routes without a policy are denied
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := a.policyFor(r.URL.Path)
+		if p != nil && p.GetAllowAnonymous() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		id := a.authenticate(r)
+		if id == nil {
+			a.challenge(w)
+			return
+		}
+		if p == nil || !a.authorize(p, id, r.URL.Path) {
+			logger.Log.Printf("%v identity %v denied access to %v", id.Source, id.Name, r.URL.Path)
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
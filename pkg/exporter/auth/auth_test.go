@@ -0,0 +1,150 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"golang.org/x/crypto/bcrypt"
+	"gotest.tools/assert"
+)
+
+type fakeKube struct {
+	tokenReviews int
+	sarReviews   int
+	apiErr       error // returned by the token reviews when set
+}
+
+func (f *fakeKube) ReviewToken(token string) (string, []string, error) {
+	f.tokenReviews++
+	if f.apiErr != nil {
+		return "", nil, f.apiErr
+	}
+	switch token {
+	case "prom-sa-token":
+		return "system:serviceaccount:monitoring:prometheus", []string{"system:serviceaccounts"}, nil
+	case "admin-sa-token":
+		return "system:serviceaccount:kube-system:admin", []string{"system:serviceaccounts", "admins"}, nil
+	case "ops-sa-token":
+		return "system:serviceaccount:kube-system:ops", []string{"system:serviceaccounts"}, nil
+	}
+	return "", nil, fmt.Errorf("%w, invalid token", ErrUnauthenticated)
+}
+
+func (f *fakeKube) ReviewAccess(user string, groups []string, path, verb string) (bool, error) {
+	f.sarReviews++
+	return user == "system:serviceaccount:monitoring:prometheus" && path == "/metrics" && verb == "get", nil
+}
+
+func TestAuthenticator(t *testing.T) {
+	logger.Init(true)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.Assert(t, os.WriteFile(tokenFile, []byte("static-secret\n"), 0600) == nil)
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	assert.Assert(t, err == nil)
+
+	kube := &fakeKube{}
+	a, err := NewAuthenticator(&exportermetrics.AuthConfig{
+		BearerTokenFiles:      map[string]string{"scraper": tokenFile},
+		BasicAuthUsers:        map[string]string{"ops": string(hash)},
+		KubernetesTokenReview: true,
+		Policies: []*exportermetrics.AuthPolicy{
+			{PathPrefix: "/metrics", Identities: []string{"scraper"}, SubjectAccessReview: true},
+			{PathPrefix: "/debug", Identities: []string{"ops", "group:admins"}},
+			{PathPrefix: "/debug/vars", AllowAnonymous: true},
+		},
+	}, kube)
+	assert.Assert(t, err == nil, "authenticator create failed %v", err)
+
+	handler := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	do := func(path, bearer, user, pass string) int {
+		r := httptest.NewRequest("GET", path, nil)
+		if bearer != "" {
+			r.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		if user != "" {
+			r.SetBasicAuth(user, pass)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	tests := []struct {
+		path, bearer, user, pass string
+		code                     int
+	}{
+		{"/metrics", "", "", "", http.StatusUnauthorized},
+		{"/metrics", "static-secret", "", "", http.StatusOK},
+		{"/metrics/gpu", "static-secret", "", "", http.StatusOK},
+		{"/metrics", "wrong", "", "", http.StatusUnauthorized},
+		{"/metrics", "prom-sa-token", "", "", http.StatusOK},
+		{"/metrics", "admin-sa-token", "", "", http.StatusForbidden},
+		{"/metrics", "", "ops", "pass", http.StatusForbidden},
+		{"/debug/pprof/", "", "ops", "pass", http.StatusOK},
+		{"/debug/pprof/", "", "ops", "wrong", http.StatusUnauthorized},
+		{"/debug/pprof/", "admin-sa-token", "", "", http.StatusOK},
+		{"/debug/pprof/", "prom-sa-token", "", "", http.StatusForbidden},
+		{"/debug/pprof/", "static-secret", "", "", http.StatusForbidden},
+		{"/debug/vars", "", "", "", http.StatusOK},
+		// prefixes match whole segments
+		{"/debug/varsfoo", "", "", "", http.StatusUnauthorized},
+		{"/metricsfoo", "static-secret", "", "", http.StatusForbidden},
+		// no policy
+		{"/other", "static-secret", "", "", http.StatusForbidden},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, do(tc.path, tc.bearer, tc.user, tc.pass), tc.code, "%+v", tc)
+	}
+
+	// reviews are cached
+	tokenReviews, sarReviews := kube.tokenReviews, kube.sarReviews
+	assert.Equal(t, do("/metrics", "prom-sa-token", "", ""), http.StatusOK)
+	assert.Equal(t, do("/metrics", "wrong", "", ""), http.StatusUnauthorized)
+	assert.Equal(t, kube.tokenReviews, tokenReviews)
+	assert.Equal(t, kube.sarReviews, sarReviews)
+
+	// api errors are not cached, the next request is reviewed again
+	kube.apiErr = fmt.Errorf("apiserver timeout")
+	assert.Equal(t, do("/debug/pprof/", "ops-sa-token", "", ""), http.StatusUnauthorized)
+	kube.apiErr = nil
+	assert.Equal(t, do("/debug/pprof/", "ops-sa-token", "", ""), http.StatusForbidden)
+	assert.Equal(t, kube.tokenReviews, tokenReviews+2)
+
+	// token files are watched by directory
+	assert.DeepEqual(t, TokenFileDirs(&exportermetrics.AuthConfig{
+		BearerTokenFiles: map[string]string{"a": tokenFile, "b": tokenFile, "c": "/var/run/secrets/c"},
+	}), []string{filepath.Dir(tokenFile), "/var/run/secrets"})
+
+	// invalid config
+	_, err = NewAuthenticator(&exportermetrics.AuthConfig{
+		BasicAuthUsers: map[string]string{"ops": "plaintext"},
+	}, nil)
+	assert.Assert(t, err != nil, "expecting invalid bcrypt hash error")
+	_, err = NewAuthenticator(&exportermetrics.AuthConfig{
+		Policies: []*exportermetrics.AuthPolicy{{PathPrefix: "metrics"}},
+	}, nil)
+	assert.Assert(t, err != nil, "expecting invalid path prefix error")
+}
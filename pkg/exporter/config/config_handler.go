@@ -17,15 +17,19 @@
 package config
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"sync"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
 )
 
+const redacted = "<redacted>"
+
 // ConfigHandler to update/read config data layer
 type ConfigHandler struct {
 	sync.Mutex
@@ -34,6 +38,11 @@ type ConfigHandler struct {
 	configPath    string
 	// running config can change keep updating states
 	runningConfig *Config
+	// set once a config file is loaded, kept on invalid reloads
+	loaded bool
+	// node the overrides are matched with, read on every refresh
+	nodeInfo         func(slurmPartitions bool) NodeInfo
+	matchedOverrides []string
 }
 
 func NewConfigHandler(configPath string, port int) *ConfigHandler {
@@ -42,19 +51,65 @@ func NewConfigHandler(configPath string, port int) *ConfigHandler {
 		configPath:    configPath,
 		runningConfig: NewConfig(),
 		grpcAgentPort: port,
+		nodeInfo:      defaultNodeInfo,
 	}
 	return c
 }
 
-func (c *ConfigHandler) RefreshConfig() error {
+// SetNodeInfoFunc sets the node info source of the node overrides, it is
+// called without the handler lock on every refresh, slurmPartitions is set
+// when an override matches on them
+func (c *ConfigHandler) SetNodeInfoFunc(nodeInfo func(slurmPartitions bool) NodeInfo) {
 	c.Lock()
 	defer c.Unlock()
+	c.nodeInfo = nodeInfo
+}
+
+// RefreshConfig reloads the config file with the env overrides applied, an
+// invalid config keeps the running one, the caller is expected to stop
+// when no config was loaded yet rather than run the defaults
+func (c *ConfigHandler) RefreshConfig() error {
 	newConfig, err := readConfig(c.configPath)
+	var node NodeInfo
+	if err == nil {
+		// the node lookup may exec or query the apiserver, keep it unlocked
+		c.Lock()
+		nodeInfo := c.nodeInfo
+		c.Unlock()
+		node = nodeInfo(needSlurmPartitions(newConfig))
+	}
+
+	c.Lock()
+	defer c.Unlock()
 	if err != nil {
-		logger.Log.Printf("config read err: %v, reverting to defaults", err)
-		return c.runningConfig.Update(nil)
+		selfmetrics.SetConfigValid(false)
+		if c.loaded {
+			logger.Log.Printf("invalid config %v, keeping the running config: %v", c.configPath, err)
+		} else {
+			logger.Log.Printf("invalid config %v: %v", c.configPath, err)
+		}
+		return err
+	}
+	selfmetrics.SetConfigValid(true)
+	matched := applyNodeOverrides(newConfig, node)
+	if err := c.runningConfig.Update(newConfig); err != nil {
+		return err
+	}
+	if len(newConfig.GetNodeOverrides()) != 0 {
+		logger.Log.Printf("node overrides matched: %v", matched)
 	}
-	return c.runningConfig.Update(newConfig)
+	c.matchedOverrides = matched
+	selfmetrics.SetMatchedOverrides(matched)
+	c.loaded = true
+	return nil
+}
+
+// GetMatchedOverrides returns the names of the node overrides applied to
+// the running config
+func (c *ConfigHandler) GetMatchedOverrides() []string {
+	c.Lock()
+	defer c.Unlock()
+	return append([]string{}, c.matchedOverrides...)
 }
 
 // GetHealthServiceState returns the health service state
@@ -73,6 +128,21 @@ func (c *ConfigHandler) GetHealthServiceState() bool {
 	return true
 }
 
+// GetHealthGatewayPort returns the HTTP/JSON gateway port of the health
+// service, 0 when the gateway or the health service is disabled
+func (c *ConfigHandler) GetHealthGatewayPort() uint32 {
+	if !c.GetHealthServiceState() {
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+	cfg := c.runningConfig.GetConfig()
+	if cfg == nil {
+		return 0
+	}
+	return cfg.GetCommonConfig().GetHealthService().GetGatewayPort()
+}
+
 func (c *ConfigHandler) GetMetricsConfigPath() string {
 	return c.configPath
 }
@@ -87,23 +157,108 @@ func (c *ConfigHandler) GetConfig() *exportermetrics.MetricConfig {
 	return c.runningConfig.GetConfig()
 }
 
+// GetEffectiveConfig returns a copy of the running config with the env
+// overrides applied and the secrets redacted
+func (c *ConfigHandler) GetEffectiveConfig() *exportermetrics.MetricConfig {
+	c.Lock()
+	defer c.Unlock()
+	cfg := proto.Clone(c.runningConfig.GetConfig()).(*exportermetrics.MetricConfig)
+	cfg.ServerPort = c.runningConfig.GetServerPort()
+	redact := func(m map[string]string) {
+		for k := range m {
+			m[k] = redacted
+		}
+	}
+	redact(cfg.GetAuth().GetBasicAuthUsers())
+	redact(cfg.GetOTLP().GetHeaders())
+	redact(cfg.GetRemoteWrite().GetHeaders())
+	return cfg
+}
+
 func (c *ConfigHandler) GetServerPort() uint32 {
 	c.Lock()
 	defer c.Unlock()
 	return c.runningConfig.GetServerPort()
 }
 
+// GetTLSConfig returns the metrics server TLS config, nil if plain HTTP is
+// to be served
+func (c *ConfigHandler) GetTLSConfig() *exportermetrics.TLSConfig {
+	c.Lock()
+	defer c.Unlock()
+	cfg := c.runningConfig.GetConfig()
+	if cfg == nil {
+		return nil
+	}
+	return cfg.GetTLS()
+}
+
+// GetAllowPlainHTTP returns true if the metrics server can serve plain HTTP
+// when TLS is not configured
+func (c *ConfigHandler) GetAllowPlainHTTP() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.runningConfig.GetConfig().GetAllowPlainHTTP()
+}
+
+// GetAuthConfig returns the metrics server auth config, nil if the routes
+// are open
+func (c *ConfigHandler) GetAuthConfig() *exportermetrics.AuthConfig {
+	c.Lock()
+	defer c.Unlock()
+	cfg := c.runningConfig.GetConfig()
+	if cfg == nil {
+		return nil
+	}
+	return cfg.GetAuth()
+}
+
+// GetOTLPConfig returns the OTLP push config, nil if push is disabled
+func (c *ConfigHandler) GetOTLPConfig() *exportermetrics.OTLPConfig {
+	c.Lock()
+	defer c.Unlock()
+	cfg := c.runningConfig.GetConfig()
+	if cfg == nil {
+		return nil
+	}
+	return cfg.GetOTLP()
+}
+
+// GetRemoteWriteConfig returns the remote write config, nil if disabled
+func (c *ConfigHandler) GetRemoteWriteConfig() *exportermetrics.RemoteWriteConfig {
+	c.Lock()
+	defer c.Unlock()
+	cfg := c.runningConfig.GetConfig()
+	if cfg == nil {
+		return nil
+	}
+	return cfg.GetRemoteWrite()
+}
+
+// GetScrapeProfile returns the named scrape profile, nil if not configured
+func (c *ConfigHandler) GetScrapeProfile(name string) *exportermetrics.ScrapeProfile {
+	c.Lock()
+	defer c.Unlock()
+	cfg := c.runningConfig.GetConfig()
+	if cfg == nil {
+		return nil
+	}
+	return cfg.GetScrapeProfiles()[name]
+}
+
+// readConfig reads the JSON or YAML config file, running without a config
+// file is valid
 func readConfig(filepath string) (*exportermetrics.MetricConfig, error) {
-	var config_fields exportermetrics.MetricConfig
-	pmConfigs := &config_fields
-	mConfigs, err := os.ReadFile(filepath)
+	data, err := os.ReadFile(filepath)
 	if err != nil {
-		return nil, err
-	} else {
-		err = json.Unmarshal(mConfigs, pmConfigs)
-		if err != nil {
+		if !os.IsNotExist(err) {
 			return nil, err
 		}
+		logger.Log.Printf("config %v not found, running the defaults", filepath)
+	}
+	cfg, warnings, err := ValidateConfigWithEnv(data, os.Environ())
+	for _, w := range warnings {
+		logger.Log.Printf("config %v warning: %v", filepath, w)
 	}
-	return pmConfigs, nil
+	return cfg, err
 }
@@ -0,0 +1,229 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// env overrides are AMD_EXPORTER_<Field>__<Field>..., field names are
+	// matched case insensitively, e.g. AMD_EXPORTER_GPUCONFIG__SELECTOR
+	EnvOverridePrefix = "AMD_EXPORTER_"
+	envPathSeparator  = "__"
+)
+
+// applyEnv sets the env overrides on the config tree. Env vars with the
+// prefix not naming a config key are other exporter settings and skipped,
+// e.g. AMD_EXPORTER_RELAXED_FLAGS_PARSING
+func (v *validator) applyEnv(tree map[string]interface{}, md protoreflect.MessageDescriptor, env []string) {
+	env = append([]string{}, env...)
+	sort.Strings(env)
+	for _, kv := range env {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(name, EnvOverridePrefix) {
+			continue
+		}
+		segs := strings.Split(strings.TrimPrefix(name, EnvOverridePrefix), envPathSeparator)
+		if fieldByName(md, segs[0]) == nil {
+			continue
+		}
+		v.applyOverride(tree, md, segs, name, value)
+	}
+}
+
+func (v *validator) applyOverride(obj map[string]interface{}, md protoreflect.MessageDescriptor, segs []string, name, value string) {
+	envErr := func(path, format string, args ...interface{}) {
+		v.errs = append(v.errs, &ValidationError{Path: path, Env: name, Msg: fmt.Sprintf(format, args...)})
+	}
+	path := ""
+	for i := 0; i < len(segs); i++ {
+		fd := fieldByName(md, segs[i])
+		if fd == nil {
+			envErr(joinPath(path, segs[i]), "unknown config key %v", segs[i])
+			return
+		}
+		key := objectKey(obj, string(fd.Name()))
+		path = joinPath(path, string(fd.Name()))
+		last := i == len(segs)-1
+		switch {
+		case last:
+			val, err := envValue(fd, value)
+			if err != nil {
+				envErr(path, "%v", err)
+				return
+			}
+			obj[key] = val
+			v.env[strings.ToLower(path)] = name
+			return
+		case fd.IsMap():
+			// the next segment is the map key, taken as is
+			entries := childObject(obj, key)
+			mapKey := segs[i+1]
+			path = joinPath(path, mapKey)
+			if i+1 == len(segs)-1 {
+				val, err := envValue(fd.MapValue(), value)
+				if err != nil {
+					envErr(path, "%v", err)
+					return
+				}
+				entries[mapKey] = val
+				v.env[strings.ToLower(path)] = name
+				return
+			}
+			if fd.MapValue().Kind() != protoreflect.MessageKind {
+				envErr(path, "%v has no nested keys", path)
+				return
+			}
+			obj, md = childObject(entries, mapKey), fd.MapValue().Message()
+			i++
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList():
+			obj, md = childObject(obj, key), fd.Message()
+		default:
+			envErr(path, "%v has no nested keys", path)
+			return
+		}
+	}
+}
+
+// objectKey returns the key of obj matching the field name as encoding/json
+// does, the field name if not set
+func objectKey(obj map[string]interface{}, name string) string {
+	if _, ok := obj[name]; ok {
+		return name
+	}
+	for key := range obj {
+		if strings.EqualFold(key, name) {
+			return key
+		}
+	}
+	return name
+}
+
+func childObject(obj map[string]interface{}, key string) map[string]interface{} {
+	child, ok := obj[key].(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		obj[key] = child
+	}
+	return child
+}
+
+// envValue converts an env value to the json value of the field. Lists are
+// comma separated, maps are key=value pairs, both can also be given in JSON
+// which is required for messages
+func envValue(fd protoreflect.FieldDescriptor, value string) (interface{}, error) {
+	trimmed := strings.TrimSpace(value)
+	switch {
+	case fd.IsMap():
+		if strings.HasPrefix(trimmed, "{") || fd.MapValue().Kind() == protoreflect.MessageKind {
+			return envJSON(trimmed)
+		}
+		entries := map[string]interface{}{}
+		for _, pair := range splitList(value) {
+			k, val, ok := strings.Cut(pair, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid map entry %v, expecting key=value", pair)
+			}
+			conv, err := envScalar(fd.MapValue(), strings.TrimSpace(val))
+			if err != nil {
+				return nil, err
+			}
+			entries[strings.TrimSpace(k)] = conv
+		}
+		return entries, nil
+	case fd.IsList():
+		if strings.HasPrefix(trimmed, "[") || fd.Kind() == protoreflect.MessageKind {
+			return envJSON(trimmed)
+		}
+		items := []interface{}{}
+		for _, item := range splitList(value) {
+			conv, err := envScalar(fd, item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, conv)
+		}
+		return items, nil
+	case fd.Kind() == protoreflect.MessageKind:
+		return envJSON(trimmed)
+	}
+	return envScalar(fd, value)
+}
+
+func envScalar(fd protoreflect.FieldDescriptor, value string) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return value, nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bool %v", value)
+		}
+		return b, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if _, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid unsigned number %v", value)
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if _, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid number %v", value)
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if _, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err != nil {
+			return nil, fmt.Errorf("invalid number %v", value)
+		}
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(strings.TrimSpace(value))); ev != nil {
+			return json.Number(strconv.Itoa(int(ev.Number()))), nil
+		}
+		if _, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32); err != nil {
+			return nil, fmt.Errorf("invalid value %v", value)
+		}
+	default:
+		return nil, fmt.Errorf("%v values cannot be set from env", fd.Kind())
+	}
+	return json.Number(strings.TrimSpace(value)), nil
+}
+
+func envJSON(value string) (interface{}, error) {
+	var val interface{}
+	dec := json.NewDecoder(bytes.NewReader([]byte(value)))
+	dec.UseNumber()
+	if err := dec.Decode(&val); err != nil {
+		return nil, fmt.Errorf("invalid JSON value, %v", err)
+	}
+	return val, nil
+}
+
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
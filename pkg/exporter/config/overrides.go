@@ -0,0 +1,140 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+// NodeInfo is what the node overrides are matched with
+type NodeInfo struct {
+	Hostname string
+	// kubernetes node labels, nil outside kubernetes or before the node is
+	// known
+	Labels          map[string]string
+	SlurmPartitions []string
+}
+
+// defaultNodeInfo matches the overrides on the hostname only
+func defaultNodeInfo(bool) NodeInfo {
+	hostname, _ := utils.GetHostName()
+	return NodeInfo{Hostname: hostname}
+}
+
+// needSlurmPartitions returns true if an override matches on the slurm
+// partitions of the node
+func needSlurmPartitions(cfg *exportermetrics.MetricConfig) bool {
+	for _, o := range cfg.GetNodeOverrides() {
+		if o.GetSlurmPartition() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// overrideName returns the name the override is reported with
+func overrideName(o *exportermetrics.NodeOverride, index int) string {
+	if o.GetName() != "" {
+		return o.GetName()
+	}
+	return fmt.Sprintf("NodeOverrides[%v]", index)
+}
+
+// validateOverride returns why the override matchers are invalid, an
+// override without matchers would match every node
+func validateOverride(o *exportermetrics.NodeOverride) error {
+	if o.GetHostname() == "" && o.GetNodeSelector() == "" && o.GetSlurmPartition() == "" {
+		return fmt.Errorf("no Hostname, NodeSelector or SlurmPartition set")
+	}
+	if _, err := path.Match(o.GetHostname(), ""); err != nil {
+		return fmt.Errorf("invalid hostname glob %v, %v", o.GetHostname(), err)
+	}
+	if _, err := labels.Parse(o.GetNodeSelector()); err != nil {
+		return fmt.Errorf("invalid node selector %v, %v", o.GetNodeSelector(), err)
+	}
+	return nil
+}
+
+// matchOverride returns true if all the set matchers of the override match
+// the node
+func matchOverride(o *exportermetrics.NodeOverride, node NodeInfo) bool {
+	if validateOverride(o) != nil {
+		return false
+	}
+	if glob := o.GetHostname(); glob != "" {
+		if ok, _ := path.Match(strings.ToLower(glob), strings.ToLower(node.Hostname)); !ok {
+			return false
+		}
+	}
+	if sel := o.GetNodeSelector(); sel != "" {
+		selector, _ := labels.Parse(sel)
+		if node.Labels == nil || !selector.Matches(labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	if partition := o.GetSlurmPartition(); partition != "" {
+		found := false
+		for _, p := range node.SlurmPartitions {
+			found = found || p == partition
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// applyNodeOverrides applies the overrides matching the node to the config
+// in order and returns the names of the matched ones
+func applyNodeOverrides(cfg *exportermetrics.MetricConfig, node NodeInfo) []string {
+	matched := []string{}
+	for i, o := range cfg.GetNodeOverrides() {
+		if !matchOverride(o, node) {
+			continue
+		}
+		matched = append(matched, overrideName(o, i))
+		if cfg.GPUConfig == nil {
+			cfg.GPUConfig = &exportermetrics.GPUMetricConfig{}
+		}
+		if len(o.GetFields()) != 0 {
+			cfg.GPUConfig.Fields = o.GetFields()
+		}
+		if len(o.GetLabels()) != 0 {
+			cfg.GPUConfig.Labels = o.GetLabels()
+		}
+		if o.GetHealthThresholds() != nil {
+			cfg.GPUConfig.HealthThresholds = o.GetHealthThresholds()
+		}
+		if len(o.GetCustomLabels()) != 0 {
+			cfg.GPUConfig.CustomLabels = o.GetCustomLabels()
+		}
+		if o.GetHealthService() != nil {
+			if cfg.CommonConfig == nil {
+				cfg.CommonConfig = &exportermetrics.CommonConfig{}
+			}
+			cfg.CommonConfig.HealthService = o.GetHealthService()
+		}
+	}
+	return matched
+}
@@ -0,0 +1,94 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const overridesConfig = `{
+  "GPUConfig": {
+    "Fields": ["GPU_CLOCK"],
+    "HealthThresholds": {"GPU_ECC_UNCORRECT_SDMA": 1}
+  },
+  "NodeOverrides": [
+    {"Name": "mi300", "Hostname": "gpu-mi300-*", "Fields": ["GPU_PACKAGE_POWER"]},
+    {"NodeSelector": "pool=mi300,zone in (a,b)", "HealthThresholds": {"GPU_ECC_UNCORRECT_SDMA": 5}},
+    {"Name": "batch", "Hostname": "gpu-*", "SlurmPartition": "batch",
+     "CustomLabels": {"queue": "batch"}, "HealthService": {"Enable": false}}
+  ]
+}`
+
+func TestNodeOverrides(t *testing.T) {
+	logger.Init(true)
+	path := filepath.Join(t.TempDir(), "config.json")
+	assert.Assert(t, os.WriteFile(path, []byte(overridesConfig), 0644) == nil)
+	c := NewConfigHandler(path, 50061)
+
+	for _, tc := range []struct {
+		node      NodeInfo
+		matched   []string
+		field     string
+		threshold uint32
+		health    bool
+	}{
+		{NodeInfo{Hostname: "cpu-1"}, []string{}, "GPU_CLOCK", 1, true},
+		{NodeInfo{Hostname: "GPU-MI300-1"}, []string{"mi300"}, "GPU_PACKAGE_POWER", 1, true},
+		// all the set matchers must match
+		{NodeInfo{Hostname: "gpu-1", Labels: map[string]string{"pool": "mi300", "zone": "c"}}, []string{}, "GPU_CLOCK", 1, true},
+		{NodeInfo{Hostname: "gpu-1", SlurmPartitions: []string{"debug"}}, []string{}, "GPU_CLOCK", 1, true},
+		{NodeInfo{Hostname: "gpu-mi300-1", Labels: map[string]string{"pool": "mi300", "zone": "a"}, SlurmPartitions: []string{"debug", "batch"}},
+			[]string{"mi300", "NodeOverrides[1]", "batch"}, "GPU_PACKAGE_POWER", 5, false},
+	} {
+		node := tc.node
+		partitions := false
+		c.SetNodeInfoFunc(func(slurmPartitions bool) NodeInfo {
+			partitions = slurmPartitions
+			return node
+		})
+		assert.Assert(t, c.RefreshConfig() == nil)
+		assert.Assert(t, partitions)
+		assert.DeepEqual(t, c.GetMatchedOverrides(), tc.matched)
+		cfg := c.GetConfig()
+		assert.DeepEqual(t, cfg.GetGPUConfig().GetFields(), []string{tc.field})
+		assert.Equal(t, cfg.GetGPUConfig().GetHealthThresholds().GetGPU_ECC_UNCORRECT_SDMA(), tc.threshold)
+		assert.Equal(t, c.GetHealthServiceState(), tc.health)
+		if !tc.health {
+			assert.Equal(t, cfg.GetGPUConfig().GetCustomLabels()["queue"], "batch")
+		}
+	}
+
+	// overrides need a valid matcher
+	_, warnings, err := ValidateConfig([]byte(`{"NodeOverrides": [
+	  {"Fields": ["GPU_CLOCK"]},
+	  {"Hostname": "gpu-[", "Labels": ["CARD_MODL"]},
+	  {"NodeSelector": "pool in (a"}]}`))
+	verrs := err.(ValidationErrors)
+	paths := []string{}
+	for _, e := range verrs {
+		paths = append(paths, e.Path)
+	}
+	assert.DeepEqual(t, paths, []string{"NodeOverrides[0]", "NodeOverrides[1]", "NodeOverrides[2]"})
+	assert.Equal(t, len(warnings), 1)
+	assert.Equal(t, warnings[0].Path, "NodeOverrides[1].Labels[0]")
+}
@@ -0,0 +1,485 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/parserutil"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/relabel"
+)
+
+var (
+	// prometheus metric and label name pattern
+	promNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// export types of the cumulative fields, see metricsutil.GetMetricTypeMode
+	metricTypeModes = []string{"gauge", "counter", "both"}
+)
+
+// ValidationError is a problem of the config, Line and Column are 1 based
+// and 0 when unknown, Env is set for the values of an env override
+type ValidationError struct {
+	Path   string // json path of the value, e.g. GPUConfig.Fields[2]
+	Line   int
+	Column int
+	Env    string
+	Msg    string
+}
+
+func (e *ValidationError) Error() string {
+	loc := ""
+	if e.Env != "" {
+		loc = fmt.Sprintf("env %v: ", e.Env)
+	} else if e.Line > 0 {
+		loc = fmt.Sprintf("line %v column %v: ", e.Line, e.Column)
+	}
+	if e.Path == "" {
+		return loc + e.Msg
+	}
+	return fmt.Sprintf("%v%v: %v", loc, e.Path, e.Msg)
+}
+
+// ValidationErrors holds all the problems of a config file
+type ValidationErrors []*ValidationError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, e := range v {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ValidateConfigFile reads and validates the config file with the env
+// overrides of the process applied, the config is returned only when valid
+func ValidateConfigFile(path string) (*exportermetrics.MetricConfig, ValidationErrors, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ValidateConfigWithEnv(data, os.Environ())
+}
+
+// ValidateConfig parses and validates the config file content, JSON or
+// YAML, errors are ValidationErrors
+func ValidateConfig(data []byte) (*exportermetrics.MetricConfig, ValidationErrors, error) {
+	return ValidateConfigWithEnv(data, nil)
+}
+
+// ValidateConfigWithEnv validates the config file content with the
+// AMD_EXPORTER_ overrides of env, a list of key=value strings, applied.
+// Unknown config keys, field and label names are returned as warnings, they
+// are ignored by the exporter and don't make the config invalid
+func ValidateConfigWithEnv(data []byte, env []string) (*exportermetrics.MetricConfig, ValidationErrors, error) {
+	v := &validator{data: data, pos: map[string]position{}, env: map[string]string{}}
+	md := (&exportermetrics.MetricConfig{}).ProtoReflect().Descriptor()
+
+	tree, ok := v.parse()
+	if !ok {
+		return nil, nil, v.errs
+	}
+	v.applyEnv(tree, md, env)
+	if len(v.errs) != 0 {
+		return nil, nil, v.errs
+	}
+	merged, err := json.Marshal(tree)
+	if err != nil {
+		v.add("", "%v", err)
+		return nil, nil, v.errs
+	}
+
+	cfg := &exportermetrics.MetricConfig{}
+	if err := json.Unmarshal(merged, cfg); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			v.add(typeErr.Field, "invalid value %v, expecting %v", typeErr.Value, typeErr.Type)
+		} else {
+			v.add("", "%v", err)
+		}
+		return nil, nil, v.errs
+	}
+	v.checkKeys("", tree, md)
+	v.checkConfig(cfg)
+	v.warns.sort()
+	if len(v.errs) != 0 {
+		v.errs.sort()
+		return nil, v.warns, v.errs
+	}
+	return cfg, v.warns, nil
+}
+
+// sort orders the problems by position
+func (v ValidationErrors) sort() {
+	sort.SliceStable(v, func(i, j int) bool {
+		if v[i].Line != v[j].Line {
+			return v[i].Line < v[j].Line
+		}
+		return v[i].Column < v[j].Column
+	})
+}
+
+type position struct {
+	line, col int
+}
+
+type validator struct {
+	data  []byte
+	pos   map[string]position // lower case json path -> value position
+	env   map[string]string   // lower case json path -> env override
+	errs  ValidationErrors
+	warns ValidationErrors
+}
+
+// parse returns the generic tree of the config file, an empty file is an
+// empty config
+func (v *validator) parse() (map[string]interface{}, bool) {
+	trimmed := bytes.TrimSpace(v.data)
+	if len(trimmed) == 0 {
+		return map[string]interface{}{}, true
+	}
+	if trimmed[0] != '{' {
+		return v.parseYAML()
+	}
+	var tree interface{}
+	dec := json.NewDecoder(bytes.NewReader(v.data))
+	dec.UseNumber()
+	err := dec.Decode(&tree)
+	if err == nil && dec.More() {
+		err = fmt.Errorf("unexpected data after the config")
+	}
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		off := int64(len(v.data))
+		if errors.As(err, &syntaxErr) {
+			// the offset is past the offending byte
+			off = syntaxErr.Offset - 1
+		} else if !errors.Is(err, io.ErrUnexpectedEOF) {
+			off = dec.InputOffset()
+		}
+		line, col := v.position(off)
+		v.errs = append(v.errs, &ValidationError{Line: line, Column: col, Msg: err.Error()})
+		return nil, false
+	}
+	obj, ok := tree.(map[string]interface{})
+	if !ok {
+		v.add("", "config must be an object")
+		return nil, false
+	}
+	v.indexOffsets()
+	return obj, true
+}
+
+// add records a problem at the value of the path
+func (v *validator) add(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, v.newError(path, format, args...))
+}
+
+// warn records a problem at the value of the path which doesn't make the
+// config invalid
+func (v *validator) warn(path, format string, args ...interface{}) {
+	v.warns = append(v.warns, v.newError(path, format, args...))
+}
+
+// newError returns the problem at the value of the path, the position is of
+// the env override replacing the value or its parent if any
+func (v *validator) newError(path, format string, args ...interface{}) *ValidationError {
+	e := &ValidationError{Path: path, Msg: fmt.Sprintf(format, args...)}
+	if env, ok := v.envSource(path); ok {
+		e.Env = env
+	} else if p, ok := v.pos[strings.ToLower(path)]; ok {
+		e.Line, e.Column = p.line, p.col
+	}
+	return e
+}
+
+func (v *validator) envSource(path string) (string, bool) {
+	for p := strings.ToLower(path); p != ""; p = parentPath(p) {
+		if env, ok := v.env[p]; ok {
+			return env, true
+		}
+	}
+	return "", false
+}
+
+func parentPath(path string) string {
+	if i := strings.LastIndexAny(path, ".["); i > 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+// position returns the line and column of the first value byte at or after
+// the offset
+func (v *validator) position(off int64) (int, int) {
+	if off < 0 {
+		off = 0
+	}
+	if off > int64(len(v.data)) {
+		off = int64(len(v.data))
+	}
+	for off < int64(len(v.data)) && strings.ContainsRune(" \t\r\n:,", rune(v.data[off])) {
+		off++
+	}
+	line := 1 + bytes.Count(v.data[:off], []byte("\n"))
+	col := int(off) - bytes.LastIndexByte(v.data[:off], '\n')
+	return line, col
+}
+
+// indexOffsets records the position of every value by its json path
+func (v *validator) indexOffsets() {
+	dec := json.NewDecoder(bytes.NewReader(v.data))
+	_ = v.indexValue(dec, "")
+}
+
+func (v *validator) indexValue(dec *json.Decoder, path string) error {
+	off := dec.InputOffset()
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	v.setPos(path, off)
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			keyOff := dec.InputOffset()
+			key, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			child := joinPath(path, fmt.Sprintf("%v", key))
+			v.setPos(child+"#key", keyOff)
+			if err := v.indexValue(dec, child); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			if err := v.indexValue(dec, fmt.Sprintf("%v[%v]", path, i)); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+	}
+	return err
+}
+
+func (v *validator) setPos(path string, off int64) {
+	line, col := v.position(off)
+	v.pos[strings.ToLower(path)] = position{line: line, col: col}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// checkKeys warns on the keys not matching a field of the message, matched
+// case insensitively as encoding/json does. They are ignored so a config
+// written for a newer exporter still runs
+func (v *validator) checkKeys(path string, node interface{}, md protoreflect.MessageDescriptor) {
+	obj, ok := node.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key, val := range obj {
+		fd := fieldByName(md, key)
+		child := joinPath(path, key)
+		if fd == nil {
+			e := &ValidationError{Path: child, Msg: fmt.Sprintf("unknown config key %v, ignored", key)}
+			if p, ok := v.pos[strings.ToLower(child)+"#key"]; ok {
+				e.Line, e.Column = p.line, p.col
+			}
+			v.warns = append(v.warns, e)
+			continue
+		}
+		switch {
+		case fd.IsMap() && fd.MapValue().Kind() == protoreflect.MessageKind:
+			if entries, ok := val.(map[string]interface{}); ok {
+				for name, entry := range entries {
+					v.checkKeys(joinPath(child, name), entry, fd.MapValue().Message())
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap():
+			v.checkKeys(child, val, fd.Message())
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			if items, ok := val.([]interface{}); ok {
+				for i, item := range items {
+					v.checkKeys(fmt.Sprintf("%v[%v]", child, i), item, fd.Message())
+				}
+			}
+		}
+	}
+}
+
+// fieldByName returns the field of the message matching the name case
+// insensitively, nil if none
+func fieldByName(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if strings.EqualFold(string(fields.Get(i).Name()), name) {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
+
+func (v *validator) checkConfig(cfg *exportermetrics.MetricConfig) {
+	if gpuConf := cfg.GetGPUConfig(); gpuConf != nil {
+		v.checkFields("GPUConfig.Fields", gpuConf.GetFields())
+		v.checkLabels("GPUConfig.Labels", gpuConf.GetLabels())
+		v.checkSelector("GPUConfig.Selector", gpuConf.GetSelector())
+		v.checkCustomLabels("GPUConfig.CustomLabels", gpuConf.GetCustomLabels())
+		v.checkExtraPodLabels(gpuConf.GetExtraPodLabels())
+		v.checkModelProfiles(gpuConf.GetModelProfiles())
+	}
+	if common := cfg.GetCommonConfig(); common != nil {
+		if prefix := common.GetMetricsFieldPrefix(); prefix != "" && !promNameRe.MatchString(prefix) {
+			v.add("CommonConfig.MetricsFieldPrefix", "invalid prefix %v, must match %v", prefix, promNameRe)
+		}
+		for i, rc := range common.GetRelabelConfigs() {
+			if _, err := relabel.NewRule(rc); err != nil {
+				v.add(fmt.Sprintf("CommonConfig.RelabelConfigs[%v]", i), "%v", err)
+			}
+		}
+		if mode := common.GetMetricTypeMode(); mode != "" {
+			found := false
+			for _, m := range metricTypeModes {
+				found = found || strings.EqualFold(m, mode)
+			}
+			if !found {
+				v.add("CommonConfig.MetricTypeMode", "invalid mode %v, must be one of %v", mode, metricTypeModes)
+			}
+		}
+	}
+	for i, o := range cfg.GetNodeOverrides() {
+		path := fmt.Sprintf("NodeOverrides[%v]", i)
+		if err := validateOverride(o); err != nil {
+			v.add(path, "%v", err)
+		}
+		v.checkFields(path+".Fields", o.GetFields())
+		v.checkLabels(path+".Labels", o.GetLabels())
+		v.checkCustomLabels(path+".CustomLabels", o.GetCustomLabels())
+	}
+	for name, profile := range cfg.GetScrapeProfiles() {
+		path := "ScrapeProfiles." + name
+		if !promNameRe.MatchString(name) {
+			v.add(path, "invalid profile name %v, must match %v", name, promNameRe)
+		}
+		v.checkFields(path+".Fields", profile.GetFields())
+		v.checkSelector(path+".Selector", profile.GetSelector())
+		for i, label := range profile.GetLabels() {
+			if !promNameRe.MatchString(label) {
+				v.add(fmt.Sprintf("%v.Labels[%v]", path, i), "invalid label name %v", label)
+			}
+		}
+	}
+}
+
+func (v *validator) checkFields(path string, fields []string) {
+	for i, field := range fields {
+		if _, ok := exportermetrics.GPUMetricField_value[strings.ToUpper(field)]; !ok {
+			v.warn(fmt.Sprintf("%v[%v]", path, i), "unknown field %v, ignored", field)
+		}
+	}
+}
+
+func (v *validator) checkLabels(path string, labels []string) {
+	for i, label := range labels {
+		if _, ok := exportermetrics.GPUMetricLabel_value[strings.ToUpper(label)]; !ok {
+			v.warn(fmt.Sprintf("%v[%v]", path, i), "unknown label %v, ignored", label)
+		}
+	}
+}
+
+// checkModelProfiles checks the profile settings, keys are matched case
+// insensitively so two keys differing in case would be ambiguous
+func (v *validator) checkModelProfiles(profiles map[string]*exportermetrics.GPUModelProfile) {
+	names := make([]string, 0, len(profiles))
+	for key := range profiles {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+	keys := map[string]string{}
+	for _, key := range names {
+		profile := profiles[key]
+		path := "GPUConfig.ModelProfiles." + key
+		if key == "" {
+			v.add(path, "profile key is empty")
+		}
+		if other, ok := keys[strings.ToLower(key)]; ok {
+			v.add(path, "profile %v matches the same GPUs as %v", key, other)
+		}
+		keys[strings.ToLower(key)] = key
+		v.checkFields(path+".Fields", profile.GetFields())
+		v.checkLabels(path+".Labels", profile.GetLabels())
+	}
+}
+
+func (v *validator) checkSelector(path, selector string) {
+	if selector == "" {
+		return
+	}
+	if _, err := parserutil.RangeStrToIntIndices(selector); err != nil {
+		v.add(path, "invalid selector %v, %v", selector, err)
+	}
+}
+
+func (v *validator) checkCustomLabels(path string, labels map[string]string) {
+	if len(labels) > globals.MaxSupportedCustomLabels {
+		v.add(path, "%v custom labels set, at most %v are supported",
+			len(labels), globals.MaxSupportedCustomLabels)
+	}
+	for label := range labels {
+		path := path + "." + label
+		if !promNameRe.MatchString(label) {
+			v.add(path, "invalid label name %v", label)
+			continue
+		}
+		name := strings.ToUpper(label)
+		if _, ok := exportermetrics.GPUMetricLabel_value[name]; ok && name != exportermetrics.GPUMetricLabel_CLUSTER_NAME.String() {
+			v.add(path, "label %v cannot be customized", label)
+		}
+	}
+}
+
+func (v *validator) checkExtraPodLabels(labels map[string]string) {
+	if len(labels) > globals.MaxSupportedPodLabels {
+		v.add("GPUConfig.ExtraPodLabels", "%v pod labels set, at most %v are supported",
+			len(labels), globals.MaxSupportedPodLabels)
+	}
+	for label := range labels {
+		if !promNameRe.MatchString(label) {
+			v.add("GPUConfig.ExtraPodLabels."+label, "invalid label name %v", label)
+		}
+	}
+}
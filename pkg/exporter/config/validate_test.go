@@ -0,0 +1,235 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const invalidConfig = `{
+  "GPUConfig": {
+    "Fields": ["GPU_CLOCK", "GPU_CLOK"],
+    "Labels": ["GPU_UUID", "HOSTNAME", "CARD_MODL"],
+    "Selector": "0-x",
+    "CustomLabels": {"cluster_name": "a", "gpu_id": "b"}
+  },
+  "CommonConfig": {
+    "MetricsFieldPrefix": "amd-",
+    "MetricTypeMode": "histogram",
+    "HealthServce": {"Enable": true}
+  }
+}`
+
+func TestValidateConfig(t *testing.T) {
+	cfg, warnings, err := ValidateConfigFile("../../../example/config.json")
+	assert.Assert(t, err == nil, "example config invalid, %v", err)
+	assert.Equal(t, len(warnings), 0, "%v", warnings)
+	assert.Assert(t, len(cfg.GetGPUConfig().GetFields()) != 0)
+
+	_, warnings, err = ValidateConfig([]byte(invalidConfig))
+	verrs, ok := err.(ValidationErrors)
+	assert.Assert(t, ok, "expecting validation errors, got %v", err)
+	// unknown names and keys are ignored, they don't make the config invalid
+	expectedWarnings := []ValidationError{
+		{Path: "GPUConfig.Fields[1]", Line: 3, Column: 29},
+		{Path: "GPUConfig.Labels[2]", Line: 4, Column: 40},
+		{Path: "CommonConfig.HealthServce", Line: 11, Column: 5},
+	}
+	assert.Equal(t, len(warnings), len(expectedWarnings), "%v", warnings)
+	for i, e := range expectedWarnings {
+		assert.Equal(t, warnings[i].Path, e.Path)
+		assert.Equal(t, warnings[i].Line, e.Line, "%v", warnings[i])
+		assert.Equal(t, warnings[i].Column, e.Column, "%v", warnings[i])
+	}
+	expected := []ValidationError{
+		{Path: "GPUConfig.Selector", Line: 5, Column: 17},
+		{Path: "GPUConfig.CustomLabels.gpu_id", Line: 6, Column: 53},
+		{Path: "CommonConfig.MetricsFieldPrefix", Line: 9, Column: 27},
+		{Path: "CommonConfig.MetricTypeMode", Line: 10, Column: 23},
+	}
+	assert.Equal(t, len(verrs), len(expected), "%v", verrs)
+	for i, e := range expected {
+		assert.Equal(t, verrs[i].Path, e.Path)
+		assert.Equal(t, verrs[i].Line, e.Line, "%v", verrs[i])
+		assert.Equal(t, verrs[i].Column, e.Column, "%v", verrs[i])
+	}
+
+	// a config of a newer exporter runs without its new keys
+	cfg, warnings, err = ValidateConfig([]byte(`{"ServerPort": 5000, "NewSetting": {"Enable": true}}`))
+	assert.Assert(t, err == nil, "%v", err)
+	assert.Equal(t, cfg.GetServerPort(), uint32(5000))
+	assert.Equal(t, len(warnings), 1, "%v", warnings)
+	assert.Equal(t, warnings[0].Path, "NewSetting")
+
+	// syntax and type errors stop the validation
+	_, _, err = ValidateConfig([]byte("{\n  \"GPUConfig\": {\n    \"Fields\": [\"GPU_CLOCK\",]\n  }\n}"))
+	verrs = err.(ValidationErrors)
+	assert.Equal(t, len(verrs), 1)
+	assert.Equal(t, verrs[0].Line, 3)
+	_, _, err = ValidateConfig([]byte(`{"GPUConfig": {"HealthThresholds": {"GPU_ECC_UNCORRECT_SDMA": -1}}}`))
+	verrs = err.(ValidationErrors)
+	assert.Equal(t, len(verrs), 1)
+	assert.Equal(t, verrs[0].Path, "GPUConfig.HealthThresholds.GPU_ECC_UNCORRECT_SDMA")
+
+	// model profile keys are matched case insensitively
+	_, warnings, err = ValidateConfig([]byte(`{"GPUConfig": {"ModelProfiles": {
+	  "MI300X": {"Fields": ["GPU_CLOK"]},
+	  "mi300x": {"Labels": ["CARD_SERIES"]}}}}`))
+	verrs = err.(ValidationErrors)
+	assert.Equal(t, len(verrs), 1, "%v", verrs)
+	assert.Equal(t, verrs[0].Path, "GPUConfig.ModelProfiles.mi300x")
+	assert.Equal(t, len(warnings), 1, "%v", warnings)
+	assert.Equal(t, warnings[0].Path, "GPUConfig.ModelProfiles.MI300X.Fields[0]")
+
+	_, _, err = ValidateConfig([]byte(`{"CommonConfig": {"RelabelConfigs": [
+	  {"SourceLabels": ["__name__"], "Regex": "gpu_(.*)", "TargetLabel": "__name__", "Replacement": "amd_gpu_$1"},
+	  {"SourceLabels": ["gpu_id"], "Action": "hashmod", "TargetLabel": "shard"}]}}`))
+	verrs = err.(ValidationErrors)
+	assert.Equal(t, len(verrs), 1, "%v", verrs)
+	assert.Equal(t, verrs[0].Path, "CommonConfig.RelabelConfigs[1]")
+}
+
+func TestRefreshInvalidConfig(t *testing.T) {
+	logger.Init(true)
+	path := filepath.Join(t.TempDir(), "config.json")
+	c := NewConfigHandler(path, 50061)
+
+	// no config file runs with the defaults
+	assert.Assert(t, c.RefreshConfig() == nil)
+
+	// an invalid file at startup is reported, the exporter doesn't start
+	assert.Assert(t, os.WriteFile(path, []byte(invalidConfig), 0644) == nil)
+	assert.Assert(t, c.RefreshConfig() != nil)
+	assert.Assert(t, c.GetConfig().GetCommonConfig() == nil)
+
+	// unknown field names are ignored
+	withUnknownField := `{"GPUConfig": {"Fields": ["GPU_CLOCK", "GPU_TOTAL_MEMORY"]}}`
+	assert.Assert(t, os.WriteFile(path, []byte(withUnknownField), 0644) == nil)
+	assert.Assert(t, c.RefreshConfig() == nil)
+	assert.DeepEqual(t, c.GetConfig().GetGPUConfig().GetFields(), []string{"GPU_CLOCK", "GPU_TOTAL_MEMORY"})
+
+	valid := `{"CommonConfig": {"MetricsFieldPrefix": "amd"}}`
+	assert.Assert(t, os.WriteFile(path, []byte(valid), 0644) == nil)
+	assert.Assert(t, c.RefreshConfig() == nil)
+	assert.Equal(t, c.GetConfig().GetCommonConfig().GetMetricsFieldPrefix(), "amd")
+
+	// a failed reload keeps the running config
+	assert.Assert(t, os.WriteFile(path, []byte(invalidConfig), 0644) == nil)
+	assert.Assert(t, c.RefreshConfig() != nil)
+	assert.Equal(t, c.GetConfig().GetCommonConfig().GetMetricsFieldPrefix(), "amd")
+}
+
+func TestValidateConfigYAML(t *testing.T) {
+	yamlConfig := `GPUConfig:
+  Selector: 0
+  Fields:
+    - GPU_CLOCK
+    - GPU_CLOK
+  HealthThresholds:
+    GPU_ECC_UNCORRECT_SDMA: 5
+CommonConfig:
+  MetricsFieldPrefix: amd
+`
+	_, warnings, err := ValidateConfig([]byte(yamlConfig))
+	assert.Assert(t, err == nil, "%v", err)
+	assert.Equal(t, len(warnings), 1, "%v", warnings)
+	assert.Equal(t, warnings[0].Path, "GPUConfig.Fields[1]")
+	assert.Equal(t, warnings[0].Line, 5)
+	assert.Equal(t, warnings[0].Column, 7)
+
+	// unquoted scalars are kept as text for string fields
+	cfg, warnings, err := ValidateConfig([]byte(strings.Replace(yamlConfig, "GPU_CLOK", "GPU_POWER_USAGE", 1)))
+	assert.Assert(t, err == nil, "%v", err)
+	assert.Equal(t, len(warnings), 0, "%v", warnings)
+	assert.Equal(t, cfg.GetGPUConfig().GetSelector(), "0")
+	assert.Equal(t, cfg.GetGPUConfig().GetHealthThresholds().GetGPU_ECC_UNCORRECT_SDMA(), uint32(5))
+	assert.Equal(t, cfg.GetCommonConfig().GetMetricsFieldPrefix(), "amd")
+}
+
+func TestEnvOverrides(t *testing.T) {
+	file := `{"GPUConfig": {"Selector": "0-7", "Fields": ["GPU_CLOCK"]}, "commonconfig": {"MetricsFieldPrefix": "amd"}}`
+	env := []string{
+		"AMD_EXPORTER_RELAXED_FLAGS_PARSING=1",
+		"AMD_EXPORTER_SERVERPORT=5001",
+		"AMD_EXPORTER_GPUCONFIG__SELECTOR=0-3",
+		"AMD_EXPORTER_GPUCONFIG__FIELDS=GPU_CLOCK, GPU_POWER_USAGE",
+		"AMD_EXPORTER_GPUCONFIG__CUSTOMLABELS=zone=a,rack=b",
+		"AMD_EXPORTER_GPUCONFIG__HEALTHTHRESHOLDS__GPU_ECC_UNCORRECT_SDMA=3",
+		"AMD_EXPORTER_COMMONCONFIG__METRICSFIELDPREFIX=gpu",
+		"AMD_EXPORTER_COMMONCONFIG__HEALTHSERVICE__ENABLE=false",
+		`AMD_EXPORTER_SCRAPEPROFILES__billing={"Fields": ["GPU_PACKAGE_POWER"]}`,
+	}
+	cfg, _, err := ValidateConfigWithEnv([]byte(file), env)
+	assert.Assert(t, err == nil, "%v", err)
+	assert.Equal(t, cfg.GetServerPort(), uint32(5001))
+	assert.Equal(t, cfg.GetGPUConfig().GetSelector(), "0-3")
+	assert.DeepEqual(t, cfg.GetGPUConfig().GetFields(), []string{"GPU_CLOCK", "GPU_POWER_USAGE"})
+	assert.DeepEqual(t, cfg.GetGPUConfig().GetCustomLabels(), map[string]string{"zone": "a", "rack": "b"})
+	assert.Equal(t, cfg.GetGPUConfig().GetHealthThresholds().GetGPU_ECC_UNCORRECT_SDMA(), uint32(3))
+	assert.Equal(t, cfg.GetCommonConfig().GetMetricsFieldPrefix(), "gpu")
+	assert.Equal(t, cfg.GetCommonConfig().GetHealthService().GetEnable(), false)
+	assert.DeepEqual(t, cfg.GetScrapeProfiles()["billing"].GetFields(), []string{"GPU_PACKAGE_POWER"})
+
+	// overrides apply without a config file and are validated
+	for _, tc := range []struct {
+		env  string
+		path string
+	}{
+		{"AMD_EXPORTER_GPUCONFIG__SELECTR=0", "GPUConfig.SELECTR"},
+		{"AMD_EXPORTER_GPUCONFIG__SELECTOR=0-x", "GPUConfig.Selector"},
+		{"AMD_EXPORTER_GPUCONFIG__HEALTHTHRESHOLDS__GPU_ECC_UNCORRECT_SDMA=-1", "GPUConfig.HealthThresholds.GPU_ECC_UNCORRECT_SDMA"},
+		{"AMD_EXPORTER_SERVERPORT__PORT=1", "ServerPort"},
+	} {
+		_, _, err := ValidateConfigWithEnv(nil, []string{tc.env})
+		verrs, ok := err.(ValidationErrors)
+		assert.Assert(t, ok && len(verrs) == 1, "%v: expecting an error, got %v", tc.env, err)
+		assert.Equal(t, verrs[0].Path, tc.path)
+		assert.Equal(t, verrs[0].Env+"="+strings.SplitN(tc.env, "=", 2)[1], tc.env)
+	}
+
+	// unknown names are reported with the env variable they come from
+	env = []string{"AMD_EXPORTER_GPUCONFIG__FIELDS=GPU_CLOCK,GPU_CLOK"}
+	_, warnings, err := ValidateConfigWithEnv(nil, env)
+	assert.Assert(t, err == nil, "%v", err)
+	assert.Equal(t, len(warnings), 1, "%v", warnings)
+	assert.Equal(t, warnings[0].Path, "GPUConfig.Fields[1]")
+	assert.Equal(t, warnings[0].Env, "AMD_EXPORTER_GPUCONFIG__FIELDS")
+}
+
+func TestEffectiveConfig(t *testing.T) {
+	logger.Init(true)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	config := "ServerPort: 5001\nOTLP:\n  Endpoint: collector:4317\n  Headers:\n    authorization: Bearer secret\n"
+	assert.Assert(t, os.WriteFile(path, []byte(config), 0644) == nil)
+	t.Setenv("AMD_EXPORTER_COMMONCONFIG__METRICSFIELDPREFIX", "gpu")
+	c := NewConfigHandler(path, 50061)
+	assert.Assert(t, c.RefreshConfig() == nil)
+
+	cfg := c.GetEffectiveConfig()
+	assert.Equal(t, cfg.GetServerPort(), uint32(5001))
+	assert.Equal(t, cfg.GetCommonConfig().GetMetricsFieldPrefix(), "gpu")
+	assert.Equal(t, cfg.GetOTLP().GetHeaders()["authorization"], redacted)
+	// the running config is not redacted
+	assert.Equal(t, c.GetOTLPConfig().GetHeaders()["authorization"], "Bearer secret")
+}
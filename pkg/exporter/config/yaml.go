@@ -0,0 +1,133 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+// parseYAML converts a YAML config to the generic json tree, keeping the
+// position of every value
+func (v *validator) parseYAML() (map[string]interface{}, bool) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(v.data, &doc); err != nil {
+		// yaml errors carry their line
+		v.errs = append(v.errs, &ValidationError{Msg: err.Error()})
+		return nil, false
+	}
+	if len(doc.Content) == 0 {
+		return map[string]interface{}{}, true
+	}
+	md := (&exportermetrics.MetricConfig{}).ProtoReflect().Descriptor()
+	tree, ok := v.yamlValue(doc.Content[0], "", md, false).(map[string]interface{})
+	if !ok {
+		v.add("", "config must be an object")
+		return nil, false
+	}
+	return tree, len(v.errs) == 0
+}
+
+// yamlField converts the value of a field, scalars are kept as text where
+// a string is expected so unquoted values like Selector: 0 are accepted
+func (v *validator) yamlField(n *yaml.Node, path string, fd protoreflect.FieldDescriptor) interface{} {
+	switch {
+	case fd == nil:
+		return v.yamlValue(n, path, nil, false)
+	case fd.IsMap():
+		if n.Kind != yaml.MappingNode {
+			return v.yamlValue(n, path, nil, false)
+		}
+		v.setYAMLPos(path, n)
+		entries := map[string]interface{}{}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i].Value
+			child := joinPath(path, key)
+			v.setYAMLPos(child+"#key", n.Content[i])
+			entries[key] = v.yamlValue(n.Content[i+1], child, fd.MapValue().Message(),
+				fd.MapValue().Kind() == protoreflect.StringKind)
+		}
+		return entries
+	case fd.IsList():
+		if n.Kind != yaml.SequenceNode {
+			return v.yamlValue(n, path, nil, false)
+		}
+		v.setYAMLPos(path, n)
+		items := make([]interface{}, 0, len(n.Content))
+		for i, item := range n.Content {
+			items = append(items, v.yamlValue(item, fmt.Sprintf("%v[%v]", path, i), fd.Message(),
+				fd.Kind() == protoreflect.StringKind))
+		}
+		return items
+	default:
+		return v.yamlValue(n, path, fd.Message(), fd.Kind() == protoreflect.StringKind)
+	}
+}
+
+// yamlValue converts a node, md is the message expected for a mapping and
+// str is set if a string is expected for a scalar
+func (v *validator) yamlValue(n *yaml.Node, path string, md protoreflect.MessageDescriptor, str bool) interface{} {
+	v.setYAMLPos(path, n)
+	switch n.Kind {
+	case yaml.AliasNode:
+		return v.yamlValue(n.Alias, path, md, str)
+	case yaml.MappingNode:
+		obj := map[string]interface{}{}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i].Value
+			child := joinPath(path, key)
+			v.setYAMLPos(child+"#key", n.Content[i])
+			var fd protoreflect.FieldDescriptor
+			if md != nil {
+				fd = fieldByName(md, key)
+			}
+			obj[key] = v.yamlField(n.Content[i+1], child, fd)
+		}
+		return obj
+	case yaml.SequenceNode:
+		items := make([]interface{}, 0, len(n.Content))
+		for i, item := range n.Content {
+			items = append(items, v.yamlValue(item, fmt.Sprintf("%v[%v]", path, i), nil, false))
+		}
+		return items
+	}
+	if str && n.ShortTag() != "!!null" {
+		return n.Value
+	}
+	switch n.ShortTag() {
+	case "!!int", "!!float":
+		// keep the literal, e.g. large uint64 thresholds
+		if json.Valid([]byte(n.Value)) {
+			return json.Number(n.Value)
+		}
+	}
+	var val interface{}
+	if err := n.Decode(&val); err != nil {
+		v.add(path, "%v", err)
+	}
+	return val
+}
+
+func (v *validator) setYAMLPos(path string, n *yaml.Node) {
+	v.pos[strings.ToLower(path)] = position{line: n.Line, col: n.Column}
+}
@@ -18,6 +18,7 @@ package exporter
 
 import (
 	"context"
+	"encoding/json"
 	"expvar"
 	"fmt"
 	"log"
@@ -26,32 +27,52 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gpuagent"
 	k8sclient "github.com/ROCm/device-metrics-exporter/pkg/client"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/auth"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/config"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/healthcheck"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/metricsutil"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/otlp"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/relabel"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/remotewrite"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/restapi"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
 	metricsserver "github.com/ROCm/device-metrics-exporter/pkg/exporter/svc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
 )
 
 const (
 	metricsHandlerPrefix = "/metrics"
+	// a GPUGet older than this many collection intervals fails readiness
+	gpuGetStaleIntervals = 3
+	minGPUGetMaxAge      = time.Minute
 )
 
 var (
 	mh                 *metricsutil.MetricsHandler
 	gpuclient          *gpuagent.GPUAgentClient
 	runConf            *config.ConfigHandler
+	probes             = healthcheck.NewRegistry()
 	debounceDuration   = 3 * time.Second // debounce duration for file watcher
 	defaultBindAddress = "0.0.0.0"
+	// start of the counters pushed without a created timestamp, kept across
+	// pusher restarts so a reload isn't seen as a counter reset
+	processStartTime = time.Now()
 )
 
 // ExporterOption set desired option
@@ -67,64 +88,248 @@ type Exporter struct {
 	svcHandler    *metricsserver.SvcHandler
 	ctx           context.Context
 	cancel        context.CancelFunc
+	nodeChanged   chan struct{} // node labels changed, overrides to match again
+	// slurm partitions of the node, looked up once
+	partitionsOnce sync.Once
+	partitions     []string
 }
 
-// get the info from gpu agent and update the current metrics registery
+// metrics are collected in the background, mark the response with the time
+// of the snapshot being served
 func prometheusMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		url := r.URL.String()
 		if strings.Contains(strings.ToLower(url), metricsHandlerPrefix) {
-			// pull metrics only for metrics handler
-			_ = mh.UpdateMetrics()
+			if ts := mh.GetSnapshotTime(); !ts.IsZero() {
+				w.Header().Set("Last-Modified", ts.UTC().Format(http.TimeFormat))
+			}
 		}
 		next.ServeHTTP(w, r)
 	})
 }
 
-func startMetricsServer(c *config.ConfigHandler, bindAddr string) *http.Server {
+// metricsGatherer returns the relabeled snapshot along with the exporter
+// metrics, which are never relabeled
+func metricsGatherer(gatherer prometheus.Gatherer) prometheus.Gatherer {
+	return prometheus.Gatherers{mh.RelabelGatherer(gatherer), selfmetrics.Gatherer()}
+}
 
-	serverPort := c.GetServerPort()
+// metricsHandler serves the snapshot, narrowed down by the scrape profile
+// of the route and the field, gpu and label query parameters. The exporter
+// metrics are never filtered.
+func metricsHandler(c *config.ConfigHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		name := mux.Vars(r)["profile"]
+		var filters []*metricsutil.MetricsFilter
+		if name != "" {
+			profile := c.GetScrapeProfile(name)
+			if profile == nil {
+				// not observed, unknown paths would add profile labels
+				http.Error(w, fmt.Sprintf("scrape profile %v not found", name), http.StatusNotFound)
+				return
+			}
+			f, err := mh.NewProfileFilter(profile)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("scrape profile %v, %v", name, err), http.StatusInternalServerError)
+				return
+			}
+			filters = append(filters, f)
+		}
+		defer selfmetrics.ObserveScrape(name, start)
+		query := r.URL.Query()
+		if query.Has("field") || query.Has("gpu") || query.Has("label") {
+			f, err := mh.NewMetricsFilter(query["field"], strings.Join(query["gpu"], ","), query["label"])
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			filters = append(filters, f)
+		}
+		gatherer := mh.GetGatherer()
+		if len(filters) != 0 {
+			gatherer = metricsutil.FilterGatherer(gatherer, filters...)
+		}
+		promhttp.HandlerFor(metricsGatherer(gatherer), promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	}
+}
+
+// configHandler serves the running config with the env overrides applied
+func configHandler(c *config.ConfigHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		data, err := protojson.MarshalOptions{UseProtoNames: true, Multiline: true}.Marshal(c.GetEffectiveConfig())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}
+}
+
+// relabelSeries is a series of the snapshot before and after relabeling
+type relabelSeries struct {
+	Before  string `json:"before"`
+	After   string `json:"after,omitempty"`
+	Dropped bool   `json:"dropped,omitempty"`
+}
+
+// relabelHandler is a relabeling dry run on the snapshot, with the running
+// rules on GET and the RelabelConfigs of the posted CommonConfig on POST
+func relabelHandler(w http.ResponseWriter, r *http.Request) {
+	rules := mh.GetRelabelRules()
+	if r.Method == http.MethodPost {
+		var common exportermetrics.CommonConfig
+		if err := json.NewDecoder(r.Body).Decode(&common); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request, %v", err), http.StatusBadRequest)
+			return
+		}
+		var err error
+		if rules, err = relabel.Compile(common.GetRelabelConfigs()); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	families, err := mh.GetGatherer().Gather()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	series := []relabelSeries{}
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			s := relabelSeries{Before: relabel.SeriesString(relabel.Series(mf.GetName(), m))}
+			if labels := rules.Relabel(mf.GetName(), m); labels != nil {
+				s.After = relabel.SeriesString(labels)
+			} else {
+				s.Dropped = true
+			}
+			series = append(series, s)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(series)
+}
+
+// newMetricsRouter builds the routes for the current config
+func newMetricsRouter(c *config.ConfigHandler, certs *certReloader, authn *auth.Authenticator) *mux.Router {
+	router := mux.NewRouter()
+	// probes are never authenticated, kubelet has no credentials nor client
+	// certificate
+	router.HandleFunc("/healthz", probes.Handler(healthcheck.Liveness))
+	router.HandleFunc("/healthz/{check}", probes.Handler(healthcheck.Liveness))
+	router.HandleFunc("/readyz", probes.Handler(healthcheck.Readiness))
+	router.HandleFunc("/readyz/{check}", probes.Handler(healthcheck.Readiness))
+
+	routes := router.NewRoute().Subrouter()
+	routes.Use(requireClientCertMiddleware(certs))
+	if authn != nil {
+		routes.Use(authn.Middleware)
+	}
+	routes.Use(prometheusMiddleware)
+
+	routes.HandleFunc(metricsHandlerPrefix, metricsHandler(c))
+	routes.HandleFunc(metricsHandlerPrefix+"/{profile}", metricsHandler(c))
+	if gpuclient != nil {
+		restapi.RegisterRoutes(routes, gpuclient)
+	}
+	// pprof and config, require a client certificate when served over TLS
+	debugRouter := routes.PathPrefix("/debug").Methods("GET").Subrouter()
+	debugRouter.Use(clientCertMiddleware(certs))
+	debugRouter.Handle("/vars", expvar.Handler())
+	debugRouter.HandleFunc("/config", configHandler(c))
+	debugRouter.HandleFunc("/relabel", relabelHandler)
+	debugRouter.HandleFunc("/pprof/", pprof.Index)
+	debugRouter.HandleFunc("/pprof/cmdline", pprof.Cmdline)
+	debugRouter.HandleFunc("/pprof/profile", pprof.Profile)
+	debugRouter.HandleFunc("/pprof/symbol", pprof.Symbol)
+	debugRouter.HandleFunc("/pprof/trace", pprof.Trace)
+	debugRouter.HandleFunc("/pprof/allocs", pprof.Handler("allocs").ServeHTTP)
+	debugRouter.HandleFunc("/pprof/block", pprof.Handler("block").ServeHTTP)
+	debugRouter.HandleFunc("/pprof/heap", pprof.Handler("heap").ServeHTTP)
+	debugRouter.HandleFunc("/pprof/mutex", pprof.Handler("mutex").ServeHTTP)
+	debugRouter.HandleFunc("/pprof/goroutine", pprof.Handler("goroutine").ServeHTTP)
+	debugRouter.HandleFunc("/pprof/threadcreate", pprof.Handler("threadcreate").ServeHTTP)
+	// relabel dry run of rules not applied yet
+	dryRunRouter := routes.PathPrefix("/debug").Methods("POST").Subrouter()
+	dryRunRouter.Use(clientCertMiddleware(certs))
+	dryRunRouter.HandleFunc("/relabel", relabelHandler)
+	return router
+}
 
+// newGatewayRouter builds the routes of the health service gateway, they
+// follow the client certificate and auth policies of the metrics server
+func newGatewayRouter(svcHandler *metricsserver.SvcHandler, certs *certReloader, authn *auth.Authenticator) *mux.Router {
 	router := mux.NewRouter()
+	router.Use(requireClientCertMiddleware(certs))
+	if authn != nil {
+		router.Use(authn.Middleware)
+	}
 	router.Use(prometheusMiddleware)
+	svcHandler.RegisterGatewayRoutes(router)
+	return router
+}
 
-	reg := mh.GetRegistry()
-	router.Handle(metricsHandlerPrefix, promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}))
-	// pprof
-	router.Methods("GET").Subrouter().Handle("/debug/vars", expvar.Handler())
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/", pprof.Index)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/profile", pprof.Profile)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/symbol", pprof.Symbol)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/trace", pprof.Trace)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/allocs", pprof.Handler("allocs").ServeHTTP)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/block", pprof.Handler("block").ServeHTTP)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/heap", pprof.Handler("heap").ServeHTTP)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/mutex", pprof.Handler("mutex").ServeHTTP)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/goroutine", pprof.Handler("goroutine").ServeHTTP)
-	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/threadcreate", pprof.Handler("threadcreate").ServeHTTP)
+// metricsServer is the listener of the metrics routes, reloads swap the
+// router and certificates behind it
+type metricsServer struct {
+	srv    *http.Server
+	port   uint32
+	certs  *certReloader // nil for plain HTTP
+	router atomic.Pointer[mux.Router]
+}
+
+func (ms *metricsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ms.router.Load().ServeHTTP(w, r)
+}
+
+func startMetricsServer(bindAddr string, serverPort uint32, certs *certReloader, router *mux.Router) *metricsServer {
+	ms := &metricsServer{
+		port:  serverPort,
+		certs: certs,
+	}
+	ms.router.Store(router)
 
 	// enforce some timeouts
-	srv := &http.Server{
+	ms.srv = &http.Server{
 		Addr:        fmt.Sprintf("%s:%v", bindAddr, serverPort),
 		ReadTimeout: 45 * time.Second,
 		IdleTimeout: 60 * time.Second,
-		Handler:     router,
+		Handler:     ms,
 	}
 
 	go func() {
-		logger.Log.Printf("serving requests on %s:%v", bindAddr, serverPort)
-		err := srv.ListenAndServe()
+		var err error
+		if certs != nil {
+			logger.Log.Printf("serving TLS requests on %s:%v", bindAddr, serverPort)
+			ms.srv.TLSConfig = certs.tlsConfig()
+			err = ms.srv.ListenAndServeTLS("", "")
+		} else {
+			logger.Log.Printf("TLS not configured, serving plain HTTP requests on %s:%v", bindAddr, serverPort)
+			err = ms.srv.ListenAndServe()
+		}
 		if err != http.ErrServerClosed {
 			log.Fatalf("ListenAndServe(): %v", err)
 		}
 		logger.Log.Printf("server on %s:%v shutdown gracefully", bindAddr, serverPort)
 	}()
-	return srv
+	return ms
+}
+
+func (ms *metricsServer) stop() {
+	logger.Log.Printf("stopping server on port %v", ms.port)
+	srvCtx, srvCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer srvCancel()
+	if err := ms.srv.Shutdown(srvCtx); err != nil {
+		panic(err) // failure/timeout shutting down the server gracefully
+	}
 }
 
 func foreverWatcher(e *Exporter) {
-	var srvHandler *http.Server
+	var server, gateway *metricsServer
+	svcRunning := false
 	configPath := runConf.GetMetricsConfigPath()
 	directory := path.Dir(configPath)
 	if err := os.MkdirAll(directory, 0755); err != nil {
@@ -132,43 +337,213 @@ func foreverWatcher(e *Exporter) {
 	}
 	logger.Log.Printf("config directory for watch : %v", directory)
 
+	// Create new watcher.
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Log.Fatal(err)
+	}
+	defer watcher.Close()
+	watchDirs := map[string]bool{}
+	// token file directories, a change there rebuilds the authenticator
+	tokenDirs := map[string]bool{}
+
 	serverRunning := func() bool {
-		return srvHandler != nil
+		return server != nil
 	}
 
-	startServer := func() {
-		if !serverRunning() {
-			mh.InitConfig()
-			serverPort := runConf.GetServerPort()
-			logger.Log.Printf("starting server on %s:%v", e.bindAddr, serverPort)
-			srvHandler = startMetricsServer(runConf, e.bindAddr)
-			go e.svcHandler.Run()
+	// the health socket only follows the HealthService state, reloads
+	// don't touch it otherwise
+	reconcileSvc := func() {
+		enabled := runConf.GetHealthServiceState()
+		if enabled && !svcRunning {
+			go func() {
+				if err := e.svcHandler.Run(); err != nil {
+					logger.Log.Printf("health gRPC server err: %v", err)
+				}
+			}()
+		} else if !enabled && svcRunning {
+			e.svcHandler.Stop()
+		}
+		svcRunning = enabled
+	}
 
+	// the gateway shares the TLS and auth settings of the metrics server,
+	// it is only rebound when its port or the TLS mode changes
+	reconcileGateway := func(authn *auth.Authenticator, certs *certReloader) {
+		port := runConf.GetHealthGatewayPort()
+		if port == 0 || port == server.port {
+			if port != 0 {
+				logger.Log.Printf("health gateway port %v is the metrics server port, gateway disabled", port)
+			}
+			if gateway != nil {
+				gateway.stop()
+				gateway = nil
+			}
+			return
+		}
+		router := newGatewayRouter(e.svcHandler, certs, authn)
+		if gateway != nil && gateway.port == port && gateway.certs == certs {
+			gateway.router.Store(router)
+			return
+		}
+		if gateway != nil {
+			gateway.stop()
 		}
+		logger.Log.Printf("starting health gateway on %s:%v", e.bindAddr, port)
+		gateway = startMetricsServer(e.bindAddr, port, certs, router)
+	}
+
+	// reloadConfig applies the config file, the running listener is kept
+	// unless the port or TLS mode changes. Invalid TLS/auth settings keep the
+	// previous ones, the server is never started with them.
+	reloadConfig := func() error {
+		configErr := mh.InitConfig()
+		reconcileSvc()
+
+		tlsConf := runConf.GetTLSConfig()
+		for _, dir := range certWatchDirs(tlsConf) {
+			if watchDirs[dir] || dir == directory {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				logger.Log.Printf("failed to watch certificate directory %v, %v", dir, err)
+				continue
+			}
+			logger.Log.Printf("certificate directory for watch : %v", dir)
+			watchDirs[dir] = true
+		}
+		var authn *auth.Authenticator
+		if authConf := runConf.GetAuthConfig(); authConf != nil {
+			for _, dir := range auth.TokenFileDirs(authConf) {
+				tokenDirs[dir] = true
+				if watchDirs[dir] || dir == directory {
+					continue
+				}
+				if err := watcher.Add(dir); err != nil {
+					logger.Log.Printf("failed to watch token directory %v, %v", dir, err)
+					continue
+				}
+				logger.Log.Printf("token directory for watch : %v", dir)
+				watchDirs[dir] = true
+			}
+			var kube auth.KubeReviewer
+			if e.k8sApiClient != nil {
+				kube = e.k8sApiClient
+			}
+			var err error
+			if authn, err = auth.NewAuthenticator(authConf, kube); err != nil {
+				// never serve open routes once auth is configured
+				logger.Log.Printf("auth config err: %v, server settings not applied", err)
+				return err
+			}
+		}
+
+		if tlsConf == nil && !runConf.GetAllowPlainHTTP() {
+			// plain HTTP stays the default, AllowPlainHTTP acknowledges it
+			logger.Log.Printf("warning: TLS is not configured, metrics are served over plain HTTP, set TLS or AllowPlainHTTP")
+		}
+
+		serverPort := runConf.GetServerPort()
+		if serverRunning() && server.port == serverPort && (server.certs != nil) == (tlsConf != nil) {
+			if tlsConf != nil {
+				if err := server.certs.SetConfig(tlsConf); err != nil {
+					logger.Log.Printf("TLS config err: %v, serving the previous certificate", err)
+					configErr = err
+				}
+			}
+			server.router.Store(newMetricsRouter(runConf, server.certs, authn))
+			logger.Log.Printf("config reloaded on %s:%v", e.bindAddr, serverPort)
+			reconcileGateway(authn, server.certs)
+			return configErr
+		}
+
+		var certs *certReloader
+		if tlsConf != nil {
+			var err error
+			if certs, err = newCertReloader(tlsConf); err != nil {
+				// never fall back to plain HTTP once TLS is configured
+				logger.Log.Printf("TLS config err: %v, server settings not applied", err)
+				return err
+			}
+		}
+		if serverRunning() {
+			// port or TLS mode change, the only case the listener is rebound
+			server.stop()
+		}
+		logger.Log.Printf("starting server on %s:%v", e.bindAddr, serverPort)
+		server = startMetricsServer(e.bindAddr, serverPort, certs, newMetricsRouter(runConf, certs, authn))
+		reconcileGateway(authn, certs)
+		return configErr
 	}
 	stopServer := func() {
+		if gateway != nil {
+			gateway.stop()
+			gateway = nil
+		}
 		if serverRunning() {
-			logger.Log.Printf("stopping server")
-			srvCtx, srvCancel := context.WithTimeout(context.Background(), 5*time.Second)
-			if err := srvHandler.Shutdown(srvCtx); err != nil {
-				panic(err) // failure/timeout shutting down the server gracefully
-			}
-			srvCancel()
-			time.Sleep(1 * time.Second)
-			srvHandler = nil
+			server.stop()
+			server = nil
+		}
+		if svcRunning {
 			e.svcHandler.Stop()
+			svcRunning = false
 		}
 	}
 
-	// start server and listen for changes later
-	startServer()
+	var pushCancel context.CancelFunc
+	// OTLP push runs independently of the metrics server
+	restartPusher := func() {
+		if pushCancel != nil {
+			pushCancel()
+			pushCancel = nil
+		}
+		otlpConf := runConf.GetOTLPConfig()
+		if otlpConf == nil {
+			return
+		}
+		pusher, err := otlp.NewPusher(otlpConf, metricsGatherer(mh.GetGatherer()), mh.GetCollectionInterval(),
+			gpuclient.GetExporterNonGPULabels(), processStartTime)
+		if err != nil {
+			logger.Log.Printf("OTLP config err: %v, push disabled", err)
+			return
+		}
+		var pushCtx context.Context
+		pushCtx, pushCancel = context.WithCancel(e.ctx)
+		go pusher.Run(pushCtx)
+	}
 
-	// Create new watcher.
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		logger.Log.Fatal(err)
+	var rwCancel context.CancelFunc
+	var rwDone chan struct{}
+	// remote write owns the wal directory, the previous sender is waited
+	// for before a new one opens it
+	restartRemoteWrite := func() {
+		if rwCancel != nil {
+			rwCancel()
+			<-rwDone
+			rwCancel = nil
+		}
+		rwConf := runConf.GetRemoteWriteConfig()
+		if rwConf == nil {
+			return
+		}
+		sender, err := remotewrite.NewSender(rwConf, metricsGatherer(mh.GetGatherer()), mh.GetCollectionInterval())
+		if err != nil {
+			logger.Log.Printf("remote write config err: %v, remote write disabled", err)
+			return
+		}
+		var rwCtx context.Context
+		rwCtx, rwCancel = context.WithCancel(e.ctx)
+		rwDone = make(chan struct{})
+		go func() {
+			defer close(rwDone)
+			sender.Run(rwCtx)
+		}()
 	}
-	defer watcher.Close()
+
+	// start server and listen for changes later
+	selfmetrics.ConfigReloaded(reloadConfig())
+	restartPusher()
+	restartRemoteWrite()
 
 	// Start listening for events.
 	go func() {
@@ -177,6 +552,8 @@ func foreverWatcher(e *Exporter) {
 			<-debounce.C
 		}
 		debounce.Reset(debounceDuration)
+		configChanged := true
+		tokensChanged := false
 
 		for e.ctx.Err() == nil {
 			select {
@@ -185,6 +562,12 @@ func foreverWatcher(e *Exporter) {
 					return
 				}
 				if event.Has(fsnotify.Create | fsnotify.Write | fsnotify.Remove | fsnotify.Rename) {
+					if path.Dir(event.Name) == directory {
+						configChanged = true
+					}
+					if tokenDirs[path.Dir(event.Name)] {
+						tokensChanged = true
+					}
 					if !debounce.Stop() {
 						select {
 						case <-debounce.C:
@@ -194,9 +577,31 @@ func foreverWatcher(e *Exporter) {
 					debounce.Reset(debounceDuration)
 				}
 			case <-debounce.C:
-				logger.Log.Printf("loading new config on %v", configPath)
-				stopServer()
-				startServer()
+				if configChanged || tokensChanged || !serverRunning() {
+					logger.Log.Printf("loading new config on %v", configPath)
+					selfmetrics.ConfigReloaded(reloadConfig())
+					if configChanged {
+						restartPusher()
+						restartRemoteWrite()
+					}
+				} else if server.certs != nil {
+					// certificates only, keep the listener
+					if err := server.certs.Reload(); err != nil {
+						logger.Log.Printf("certificate reload err: %v, serving the previous certificate", err)
+					}
+				}
+				configChanged = false
+				tokensChanged = false
+			case <-e.nodeChanged:
+				// reload as for a config change to match the overrides again
+				configChanged = true
+				if !debounce.Stop() {
+					select {
+					case <-debounce.C:
+					default:
+					}
+				}
+				debounce.Reset(debounceDuration)
 			case err, ok := <-watcher.Errors:
 				if !ok {
 					logger.Log.Printf("error: %v", err)
@@ -228,6 +633,7 @@ func NewExporter(agentGrpcport int, configFile string, opts ...ExporterOption) *
 		bindAddr:      defaultBindAddress,
 		ctx:           ctx,
 		cancel:        cancel,
+		nodeChanged:   make(chan struct{}, 1),
 	}
 	for _, o := range opts {
 		o(exporter)
@@ -258,6 +664,13 @@ func ExporterWithZmqDisable(zmqDisable bool) ExporterOption {
 	}
 }
 
+// WithBuildInfo sets the labels of the exporter_build_info metric
+func WithBuildInfo(version, gitCommit, buildDate string) ExporterOption {
+	return func(e *Exporter) {
+		selfmetrics.SetBuildInfo(version, gitCommit, buildDate)
+	}
+}
+
 func WithBindAddr(bindAddr string) ExporterOption {
 	return func(e *Exporter) {
 		logger.Log.Printf("bind address set to %s", bindAddr)
@@ -278,6 +691,12 @@ func (e *Exporter) startWatchers() {
 		return
 	}
 
+	e.k8sApiClient.SetNodeLabelsHandler(func(map[string]string) {
+		select {
+		case e.nodeChanged <- struct{}{}:
+		default:
+		}
+	})
 	if err := e.k8sApiClient.Watch(); err != nil {
 		logger.Log.Printf("failed to start k8s watchers: %v", err)
 	} else {
@@ -290,15 +709,60 @@ func (e *Exporter) startWatchers() {
 	go gpuclient.StartMonitor()
 }
 
+// nodeInfo returns the node the config overrides are matched with, node
+// labels in kubernetes and slurm partitions otherwise when an override
+// needs them
+func (e *Exporter) nodeInfo(slurmPartitions bool) config.NodeInfo {
+	hostname, _ := utils.GetHostName()
+	info := config.NodeInfo{Hostname: hostname}
+	if e.k8sApiClient != nil {
+		if node, err := e.k8sApiClient.GetNode(); err == nil {
+			info.Labels = node.Labels
+		}
+	} else if slurmPartitions {
+		e.partitionsOnce.Do(func() {
+			partitions, err := scheduler.GetNodePartitions(hostname)
+			if err != nil {
+				logger.Log.Printf("slurm partitions lookup failed, %v", err)
+			}
+			e.partitions = partitions
+		})
+		info.SlurmPartitions = e.partitions
+	}
+	return info
+}
+
+// registerProbes adds the dependency checks served on /healthz and /readyz
+func (e *Exporter) registerProbes() {
+	probes.Register("health_socket", healthcheck.Liveness, e.svcHandler.Healthy)
+	probes.Register("gpuagent_connection", healthcheck.Readiness, gpuclient.CheckConnection)
+	probes.Register("gpuagent_gpuget", healthcheck.Readiness, func() error {
+		maxAge := gpuGetStaleIntervals * mh.GetCollectionInterval()
+		if maxAge < minGPUGetMaxAge {
+			maxAge = minGPUGetMaxAge
+		}
+		return gpuclient.CheckLastGPUGet(maxAge)
+	})
+	probes.Register("gpuagent_health_poll", healthcheck.Readiness, gpuclient.CheckHealthPoll)
+	probes.Register("slurm_watcher", healthcheck.Readiness, gpuclient.CheckSlurmWatcher)
+	if e.k8sApiClient != nil {
+		probes.Register("k8s_cache_sync", healthcheck.Readiness, e.k8sApiClient.CheckCacheSync)
+	}
+}
+
 // StartMain - doesn't return it exits only on failure
 func (e *Exporter) StartMain(enableDebugAPI bool) {
 
 	logger.Init(utils.IsKubernetes())
 
 	runConf = config.NewConfigHandler(e.configFile, e.agentGrpcPort)
+	runConf.SetNodeInfoFunc(e.nodeInfo)
 
 	mh, _ = metricsutil.NewMetrics(runConf)
-	mh.InitConfig()
+	if err := mh.InitConfig(); err != nil {
+		// don't run the defaults in place of a broken config
+		logger.Log.Fatalf("invalid config %v, exiting: %v", e.configFile, err)
+	}
 
 	e.svcHandler = metricsserver.InitSvcs(enableDebugAPI, mh)
 
@@ -309,6 +773,9 @@ func (e *Exporter) StartMain(enableDebugAPI bool) {
 	defer gpuclient.Close()
 
 	e.startWatchers()
+	e.registerProbes()
+
+	go mh.StartCollector(e.ctx)
 
 	if err := e.svcHandler.RegisterHealthClient(gpuclient); err != nil {
 		logger.Log.Printf("health client registration err: %+v", err)
@@ -0,0 +1,146 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/config"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/metricsutil"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
+)
+
+// freePort returns a TCP port free at the time of the call
+func freePort(t *testing.T) uint32 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Assert(t, err == nil)
+	defer l.Close()
+	return uint32(l.Addr().(*net.TCPAddr).Port)
+}
+
+// writeTestConfig writes the config file watched by the exporter
+func writeTestConfig(t *testing.T, path string, conf *exportermetrics.MetricConfig) {
+	data, err := protojson.Marshal(conf)
+	assert.Assert(t, err == nil)
+	assert.Assert(t, os.WriteFile(path, data, 0644) == nil)
+}
+
+// startTestExporter runs the config watcher and the metrics server of the
+// config on a free port without a gpuagent, the health service is disabled.
+// It returns the config file path and the server address, the watcher is
+// stopped at the end of the test.
+func startTestExporter(t *testing.T, conf *exportermetrics.MetricConfig) (string, string) {
+	logger.Init(true)
+	conf.ServerPort = freePort(t)
+	if conf.CommonConfig == nil {
+		conf.CommonConfig = &exportermetrics.CommonConfig{}
+	}
+	conf.CommonConfig.HealthService = &exportermetrics.HealthServiceConfig{Enable: false}
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeTestConfig(t, path, conf)
+
+	runConf = config.NewConfigHandler(path, 0)
+	mh, _ = metricsutil.NewMetrics(runConf)
+	assert.Assert(t, mh.InitConfig() == nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &Exporter{
+		configFile:  path,
+		bindAddr:    "127.0.0.1",
+		ctx:         ctx,
+		cancel:      cancel,
+		nodeChanged: make(chan struct{}, 1),
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		foreverWatcher(e)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return path, fmt.Sprintf("127.0.0.1:%v", conf.ServerPort)
+}
+
+// waitServed polls the url until the server answers
+func waitServed(t *testing.T, client *http.Client, url string) *http.Response {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := client.Get(url)
+		if err == nil {
+			return resp
+		}
+		assert.Assert(t, time.Now().Before(deadline), "%v not served, %v", url, err)
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestPlainHTTPServer(t *testing.T) {
+	// no TLS settings, plain HTTP is served without AllowPlainHTTP
+	_, addr := startTestExporter(t, &exportermetrics.MetricConfig{})
+
+	resp := waitServed(t, http.DefaultClient, "http://"+addr+"/metrics")
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+
+	resp = waitServed(t, http.DefaultClient, "http://"+addr+"/healthz")
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+}
+
+func TestScrapeProfileMetrics(t *testing.T) {
+	_, addr := startTestExporter(t, &exportermetrics.MetricConfig{
+		ScrapeProfiles: map[string]*exportermetrics.ScrapeProfile{
+			"power": {Fields: []string{exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String()}},
+		},
+	})
+	for path, code := range map[string]int{"/metrics/power": http.StatusOK, "/metrics/unknown": http.StatusNotFound} {
+		resp := waitServed(t, http.DefaultClient, "http://"+addr+path)
+		resp.Body.Close()
+		assert.Equal(t, resp.StatusCode, code, path)
+	}
+
+	// unknown profiles are not observed
+	families, err := selfmetrics.Gatherer().Gather()
+	assert.Assert(t, err == nil)
+	profiles := map[string]bool{}
+	for _, mf := range families {
+		if mf.GetName() != "exporter_scrape_duration_seconds" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				profiles[l.GetValue()] = true
+			}
+		}
+	}
+	assert.Assert(t, profiles["power"])
+	assert.Assert(t, !profiles["unknown"])
+}
@@ -154,6 +154,40 @@ const (
 	GPUMetricField_GPU_GFX_BUSY_INSTANTANEOUS  GPUMetricField = 98
 	GPUMetricField_GPU_VCN_BUSY_INSTANTANEOUS  GPUMetricField = 99
 	GPUMetricField_GPU_JPEG_BUSY_INSTANTANEOUS GPUMetricField = 100
+	// fan, activity accumulators and GPU, XGMI and PCIe status
+	GPUMetricField_GPU_FAN_SPEED                   GPUMetricField = 101
+	GPUMetricField_GPU_GFX_ACTIVITY_ACCUMULATED    GPUMetricField = 102
+	GPUMetricField_GPU_MEMORY_ACTIVITY_ACCUMULATED GPUMetricField = 103
+	// 1 when throttled, 0 otherwise
+	GPUMetricField_GPU_THROTTLING_STATUS GPUMetricField = 104
+	// 1 when up, 0 when down
+	GPUMetricField_GPU_OPER_STATUS GPUMetricField = 105
+	// 0 no error, 1 one error, 2 multiple errors
+	GPUMetricField_GPU_XGMI_ERROR_STATUS GPUMetricField = 106
+	GPUMetricField_GPU_XGMI_LINK_WIDTH   GPUMetricField = 107
+	GPUMetricField_GPU_XGMI_LINK_SPEED   GPUMetricField = 108
+	GPUMetricField_PCIE_WIDTH            GPUMetricField = 109
+	GPUMetricField_PCIE_MAX_WIDTH        GPUMetricField = 110
+	// always 1, the form factor is the slot_type label
+	GPUMetricField_PCIE_SLOT_TYPE GPUMetricField = 111
+	GPUMetricField_PCIE_VERSION   GPUMetricField = 112
+	// info metrics, always 1, the versions are labels
+	GPUMetricField_GPU_FIRMWARE_INFO GPUMetricField = 113
+	GPUMetricField_GPU_HARDWARE_INFO GPUMetricField = 114
+	// retired and bad pages by page_status, from the GPUBadPageGet stream
+	GPUMetricField_GPU_BAD_PAGES GPUMetricField = 115
+	// per process usage of the KFD processes of the GPU
+	GPUMetricField_GPU_PROCESS_USED_VRAM    GPUMetricField = 116
+	GPUMetricField_GPU_PROCESS_SDMA_USAGE   GPUMetricField = 117
+	GPUMetricField_GPU_PROCESS_CU_OCCUPANCY GPUMetricField = 118
+	// derived from consecutive samples in the exporter, opt-in: only
+	// exported when listed in Fields
+	GPUMetricField_GPU_DERIVED_POWER              GPUMetricField = 119
+	GPUMetricField_GPU_DERIVED_PCIE_UTILIZATION   GPUMetricField = 120
+	GPUMetricField_GPU_DERIVED_XGMI_UTILIZATION   GPUMetricField = 121
+	GPUMetricField_GPU_DERIVED_ECC_CORRECT_RATE   GPUMetricField = 122
+	GPUMetricField_GPU_DERIVED_ECC_UNCORRECT_RATE GPUMetricField = 123
+	GPUMetricField_GPU_DERIVED_PCIE_REPLAY_RATE   GPUMetricField = 124
 	// Profiler Metrics (reserving 801 to 1200)
 	GPUMetricField_GPU_PROF_GRBM_GUI_ACTIVE                    GPUMetricField = 801
 	GPUMetricField_GPU_PROF_SQ_WAVES                           GPUMetricField = 802
@@ -318,6 +352,30 @@ var (
 		98:   "GPU_GFX_BUSY_INSTANTANEOUS",
 		99:   "GPU_VCN_BUSY_INSTANTANEOUS",
 		100:  "GPU_JPEG_BUSY_INSTANTANEOUS",
+		101:  "GPU_FAN_SPEED",
+		102:  "GPU_GFX_ACTIVITY_ACCUMULATED",
+		103:  "GPU_MEMORY_ACTIVITY_ACCUMULATED",
+		104:  "GPU_THROTTLING_STATUS",
+		105:  "GPU_OPER_STATUS",
+		106:  "GPU_XGMI_ERROR_STATUS",
+		107:  "GPU_XGMI_LINK_WIDTH",
+		108:  "GPU_XGMI_LINK_SPEED",
+		109:  "PCIE_WIDTH",
+		110:  "PCIE_MAX_WIDTH",
+		111:  "PCIE_SLOT_TYPE",
+		112:  "PCIE_VERSION",
+		113:  "GPU_FIRMWARE_INFO",
+		114:  "GPU_HARDWARE_INFO",
+		115:  "GPU_BAD_PAGES",
+		116:  "GPU_PROCESS_USED_VRAM",
+		117:  "GPU_PROCESS_SDMA_USAGE",
+		118:  "GPU_PROCESS_CU_OCCUPANCY",
+		119:  "GPU_DERIVED_POWER",
+		120:  "GPU_DERIVED_PCIE_UTILIZATION",
+		121:  "GPU_DERIVED_XGMI_UTILIZATION",
+		122:  "GPU_DERIVED_ECC_CORRECT_RATE",
+		123:  "GPU_DERIVED_ECC_UNCORRECT_RATE",
+		124:  "GPU_DERIVED_PCIE_REPLAY_RATE",
 		801:  "GPU_PROF_GRBM_GUI_ACTIVE",
 		802:  "GPU_PROF_SQ_WAVES",
 		803:  "GPU_PROF_GRBM_COUNT",
@@ -471,6 +529,30 @@ var (
 		"GPU_GFX_BUSY_INSTANTANEOUS":                         98,
 		"GPU_VCN_BUSY_INSTANTANEOUS":                         99,
 		"GPU_JPEG_BUSY_INSTANTANEOUS":                        100,
+		"GPU_FAN_SPEED":                                      101,
+		"GPU_GFX_ACTIVITY_ACCUMULATED":                       102,
+		"GPU_MEMORY_ACTIVITY_ACCUMULATED":                    103,
+		"GPU_THROTTLING_STATUS":                              104,
+		"GPU_OPER_STATUS":                                    105,
+		"GPU_XGMI_ERROR_STATUS":                              106,
+		"GPU_XGMI_LINK_WIDTH":                                107,
+		"GPU_XGMI_LINK_SPEED":                                108,
+		"PCIE_WIDTH":                                         109,
+		"PCIE_MAX_WIDTH":                                     110,
+		"PCIE_SLOT_TYPE":                                     111,
+		"PCIE_VERSION":                                       112,
+		"GPU_FIRMWARE_INFO":                                  113,
+		"GPU_HARDWARE_INFO":                                  114,
+		"GPU_BAD_PAGES":                                      115,
+		"GPU_PROCESS_USED_VRAM":                              116,
+		"GPU_PROCESS_SDMA_USAGE":                             117,
+		"GPU_PROCESS_CU_OCCUPANCY":                           118,
+		"GPU_DERIVED_POWER":                                  119,
+		"GPU_DERIVED_PCIE_UTILIZATION":                       120,
+		"GPU_DERIVED_XGMI_UTILIZATION":                       121,
+		"GPU_DERIVED_ECC_CORRECT_RATE":                       122,
+		"GPU_DERIVED_ECC_UNCORRECT_RATE":                     123,
+		"GPU_DERIVED_PCIE_REPLAY_RATE":                       124,
 		"GPU_PROF_GRBM_GUI_ACTIVE":                           801,
 		"GPU_PROF_SQ_WAVES":                                  802,
 		"GPU_PROF_GRBM_COUNT":                                803,
@@ -685,6 +767,8 @@ type GPUHealthThresholds struct {
 	GPU_ECC_UNCORRECT_JPEG      uint32 `protobuf:"varint,17,opt,name=GPU_ECC_UNCORRECT_JPEG,json=GPUECCUNCORRECTJPEG,proto3" json:"GPU_ECC_UNCORRECT_JPEG,omitempty"`
 	GPU_ECC_UNCORRECT_IH        uint32 `protobuf:"varint,18,opt,name=GPU_ECC_UNCORRECT_IH,json=GPUECCUNCORRECTIH,proto3" json:"GPU_ECC_UNCORRECT_IH,omitempty"`
 	GPU_ECC_UNCORRECT_MPIO      uint32 `protobuf:"varint,19,opt,name=GPU_ECC_UNCORRECT_MPIO,json=GPUECCUNCORRECTMPIO,proto3" json:"GPU_ECC_UNCORRECT_MPIO,omitempty"`
+	// total bad pages, 0 disables the check
+	GPU_BAD_PAGES uint32 `protobuf:"varint,20,opt,name=GPU_BAD_PAGES,json=GPUBADPAGES,proto3" json:"GPU_BAD_PAGES,omitempty"`
 }
 
 func (x *GPUHealthThresholds) Reset() {
@@ -852,6 +936,13 @@ func (x *GPUHealthThresholds) GetGPU_ECC_UNCORRECT_MPIO() uint32 {
 	return 0
 }
 
+func (x *GPUHealthThresholds) GetGPU_BAD_PAGES() uint32 {
+	if x != nil {
+		return x.GPU_BAD_PAGES
+	}
+	return 0
+}
+
 type GPUMetricConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -877,6 +968,12 @@ type GPUMetricConfig struct {
 	// if disabled all profiler related fields will not be exported to avoid reporting
 	// wrong values as 0
 	ProfilerMetrics map[string]bool `protobuf:"bytes,7,rep,name=ProfilerMetrics,proto3" json:"ProfilerMetrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Per GPU model overrides, keyed by PCI device id (e.g. 0x74a1), model
+	// name (e.g. MI300X), card model or card series, matched case
+	// insensitively in that order
+	ModelProfiles map[string]*GPUModelProfile `protobuf:"bytes,8,rep,name=ModelProfiles,proto3" json:"ModelProfiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// GPU_PROCESS_* fields settings
+	ProcessMetrics *ProcessMetricsConfig `protobuf:"bytes,9,opt,name=ProcessMetrics,proto3" json:"ProcessMetrics,omitempty"`
 }
 
 func (x *GPUMetricConfig) Reset() {
@@ -939,52 +1036,931 @@ func (x *GPUMetricConfig) GetHealthThresholds() *GPUHealthThresholds {
 	return nil
 }
 
-func (x *GPUMetricConfig) GetCustomLabels() map[string]string {
+func (x *GPUMetricConfig) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetExtraPodLabels() map[string]string {
+	if x != nil {
+		return x.ExtraPodLabels
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetProfilerMetrics() map[string]bool {
+	if x != nil {
+		return x.ProfilerMetrics
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetModelProfiles() map[string]*GPUModelProfile {
+	if x != nil {
+		return x.ModelProfiles
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetProcessMetrics() *ProcessMetricsConfig {
+	if x != nil {
+		return x.ProcessMetrics
+	}
+	return nil
+}
+
+// ProcessMetricsConfig sets where the KFD processes of the GPUs are read
+// from, e.g. /host/proc when the host procfs is mounted there
+type ProcessMetricsConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// default /proc
+	ProcfsRoot string `protobuf:"bytes,1,opt,name=ProcfsRoot,proto3" json:"ProcfsRoot,omitempty"`
+	// default /sys
+	SysfsRoot string `protobuf:"bytes,2,opt,name=SysfsRoot,proto3" json:"SysfsRoot,omitempty"`
+}
+
+func (x *ProcessMetricsConfig) Reset() {
+	*x = ProcessMetricsConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessMetricsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessMetricsConfig) ProtoMessage() {}
+
+func (x *ProcessMetricsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessMetricsConfig.ProtoReflect.Descriptor instead.
+func (*ProcessMetricsConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProcessMetricsConfig) GetProcfsRoot() string {
+	if x != nil {
+		return x.ProcfsRoot
+	}
+	return ""
+}
+
+func (x *ProcessMetricsConfig) GetSysfsRoot() string {
+	if x != nil {
+		return x.SysfsRoot
+	}
+	return ""
+}
+
+// GPUModelProfile overrides the GPUConfig settings for the GPUs of a model,
+// unset settings use the GPUConfig ones
+type GPUModelProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of all GPUMetricField to be exported for the model
+	Fields []string `protobuf:"bytes,1,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// list of labels to be exported for the model
+	Labels []string `protobuf:"bytes,2,rep,name=Labels,proto3" json:"Labels,omitempty"`
+	// GPU Health Thresholds of the model, replaces GPUConfig.HealthThresholds
+	HealthThresholds *GPUHealthThresholds `protobuf:"bytes,3,opt,name=HealthThresholds,proto3" json:"HealthThresholds,omitempty"`
+}
+
+func (x *GPUModelProfile) Reset() {
+	*x = GPUModelProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUModelProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUModelProfile) ProtoMessage() {}
+
+func (x *GPUModelProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUModelProfile.ProtoReflect.Descriptor instead.
+func (*GPUModelProfile) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GPUModelProfile) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GPUModelProfile) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *GPUModelProfile) GetHealthThresholds() *GPUHealthThresholds {
+	if x != nil {
+		return x.HealthThresholds
+	}
+	return nil
+}
+
+type HealthServiceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// TCP port of the HTTP/JSON gateway of the health service, served with
+	// the TLS and auth settings of the metrics server
+	// default/0 - disabled
+	GatewayPort uint32 `protobuf:"varint,2,opt,name=GatewayPort,proto3" json:"GatewayPort,omitempty"`
+}
+
+func (x *HealthServiceConfig) Reset() {
+	*x = HealthServiceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthServiceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthServiceConfig) ProtoMessage() {}
+
+func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthServiceConfig.ProtoReflect.Descriptor instead.
+func (*HealthServiceConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HealthServiceConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *HealthServiceConfig) GetGatewayPort() uint32 {
+	if x != nil {
+		return x.GatewayPort
+	}
+	return 0
+}
+
+type CommonConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// string to add as perfix to all exported fields
+	MetricsFieldPrefix string `protobuf:"bytes,1,opt,name=MetricsFieldPrefix,proto3" json:"MetricsFieldPrefix,omitempty"`
+	// Health Service config
+	HealthService *HealthServiceConfig `protobuf:"bytes,2,opt,name=HealthService,proto3" json:"HealthService,omitempty"`
+	// interval in seconds at which metrics are collected in the background,
+	// scrapes are served from the last completed collection
+	// default/0 - 15 seconds
+	MetricsCollectionInterval uint32 `protobuf:"varint,3,opt,name=MetricsCollectionInterval,proto3" json:"MetricsCollectionInterval,omitempty"`
+	// export type of cumulative fields (energy, ecc, pcie counts, xgmi link
+	// data and violation residency)
+	// "gauge" - default/legacy, exported as gauges
+	// "counter" - exported as counters with _total suffix
+	// "both" - counters along with the legacy gauges
+	MetricTypeMode string `protobuf:"bytes,4,opt,name=MetricTypeMode,proto3" json:"MetricTypeMode,omitempty"`
+	// prometheus style relabeling of the exported series, applied in order
+	RelabelConfigs []*RelabelConfig `protobuf:"bytes,5,rep,name=RelabelConfigs,proto3" json:"RelabelConfigs,omitempty"`
+}
+
+func (x *CommonConfig) Reset() {
+	*x = CommonConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommonConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonConfig) ProtoMessage() {}
+
+func (x *CommonConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonConfig.ProtoReflect.Descriptor instead.
+func (*CommonConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CommonConfig) GetMetricsFieldPrefix() string {
+	if x != nil {
+		return x.MetricsFieldPrefix
+	}
+	return ""
+}
+
+func (x *CommonConfig) GetHealthService() *HealthServiceConfig {
+	if x != nil {
+		return x.HealthService
+	}
+	return nil
+}
+
+func (x *CommonConfig) GetMetricsCollectionInterval() uint32 {
+	if x != nil {
+		return x.MetricsCollectionInterval
+	}
+	return 0
+}
+
+func (x *CommonConfig) GetMetricTypeMode() string {
+	if x != nil {
+		return x.MetricTypeMode
+	}
+	return ""
+}
+
+func (x *CommonConfig) GetRelabelConfigs() []*RelabelConfig {
+	if x != nil {
+		return x.RelabelConfigs
+	}
+	return nil
+}
+
+// RelabelConfig is a prometheus relabel_config rule, the metric name is the
+// __name__ label
+type RelabelConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// labels whose values are joined with Separator as the rule input
+	SourceLabels []string `protobuf:"bytes,1,rep,name=SourceLabels,proto3" json:"SourceLabels,omitempty"`
+	// default/empty - ;
+	Separator string `protobuf:"bytes,2,opt,name=Separator,proto3" json:"Separator,omitempty"`
+	// anchored regular expression, default/empty - (.*)
+	Regex string `protobuf:"bytes,3,opt,name=Regex,proto3" json:"Regex,omitempty"`
+	// modulus of the hashmod action
+	Modulus uint64 `protobuf:"varint,4,opt,name=Modulus,proto3" json:"Modulus,omitempty"`
+	// label written by replace, hashmod, lowercase and uppercase
+	TargetLabel string `protobuf:"bytes,5,opt,name=TargetLabel,proto3" json:"TargetLabel,omitempty"`
+	// replacement with the regex groups, default/empty - $1
+	Replacement string `protobuf:"bytes,6,opt,name=Replacement,proto3" json:"Replacement,omitempty"`
+	// replace (default), keep, drop, hashmod, labelmap, labeldrop,
+	// labelkeep, lowercase or uppercase
+	Action string `protobuf:"bytes,7,opt,name=Action,proto3" json:"Action,omitempty"`
+}
+
+func (x *RelabelConfig) Reset() {
+	*x = RelabelConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelabelConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelabelConfig) ProtoMessage() {}
+
+func (x *RelabelConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelabelConfig.ProtoReflect.Descriptor instead.
+func (*RelabelConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RelabelConfig) GetSourceLabels() []string {
+	if x != nil {
+		return x.SourceLabels
+	}
+	return nil
+}
+
+func (x *RelabelConfig) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RelabelConfig) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *RelabelConfig) GetModulus() uint64 {
+	if x != nil {
+		return x.Modulus
+	}
+	return 0
+}
+
+func (x *RelabelConfig) GetTargetLabel() string {
+	if x != nil {
+		return x.TargetLabel
+	}
+	return ""
+}
+
+func (x *RelabelConfig) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *RelabelConfig) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type TLSConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM encoded server certificate and key, files are reloaded on change
+	CertFile string `protobuf:"bytes,1,opt,name=CertFile,proto3" json:"CertFile,omitempty"`
+	KeyFile  string `protobuf:"bytes,2,opt,name=KeyFile,proto3" json:"KeyFile,omitempty"`
+	// PEM encoded CA bundle to verify client certificates, required for the
+	// /debug endpoints to be served
+	ClientCAFile string `protobuf:"bytes,3,opt,name=ClientCAFile,proto3" json:"ClientCAFile,omitempty"`
+	// require a verified client certificate on all the endpoints but the
+	// /healthz and /readyz probes
+	RequireClientCert bool `protobuf:"varint,4,opt,name=RequireClientCert,proto3" json:"RequireClientCert,omitempty"`
+}
+
+func (x *TLSConfig) Reset() {
+	*x = TLSConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSConfig) ProtoMessage() {}
+
+func (x *TLSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSConfig.ProtoReflect.Descriptor instead.
+func (*TLSConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TLSConfig) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *TLSConfig) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *TLSConfig) GetClientCAFile() string {
+	if x != nil {
+		return x.ClientCAFile
+	}
+	return ""
+}
+
+func (x *TLSConfig) GetRequireClientCert() bool {
+	if x != nil {
+		return x.RequireClientCert
+	}
+	return false
+}
+
+type AuthPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request path prefix the policy applies to, longest prefix wins
+	PathPrefix string `protobuf:"bytes,1,opt,name=PathPrefix,proto3" json:"PathPrefix,omitempty"`
+	// serve without authentication
+	AllowAnonymous bool `protobuf:"varint,2,opt,name=AllowAnonymous,proto3" json:"AllowAnonymous,omitempty"`
+	// authenticated identities allowed
+	// static token names, basic auth users, kubernetes user names
+	// (system:serviceaccount:<namespace>:<name>), "group:<name>" for
+	// kubernetes groups or "*" for any authenticated identity
+	Identities []string `protobuf:"bytes,3,rep,name=Identities,proto3" json:"Identities,omitempty"`
+	// authorize kubernetes identities not listed in Identities with a
+	// SubjectAccessReview on the request path and verb get
+	SubjectAccessReview bool `protobuf:"varint,4,opt,name=SubjectAccessReview,proto3" json:"SubjectAccessReview,omitempty"`
+}
+
+func (x *AuthPolicy) Reset() {
+	*x = AuthPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPolicy) ProtoMessage() {}
+
+func (x *AuthPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPolicy.ProtoReflect.Descriptor instead.
+func (*AuthPolicy) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuthPolicy) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *AuthPolicy) GetAllowAnonymous() bool {
+	if x != nil {
+		return x.AllowAnonymous
+	}
+	return false
+}
+
+func (x *AuthPolicy) GetIdentities() []string {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *AuthPolicy) GetSubjectAccessReview() bool {
+	if x != nil {
+		return x.SubjectAccessReview
+	}
+	return false
+}
+
+type AuthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// identity name -> file holding its static bearer token
+	BearerTokenFiles map[string]string `protobuf:"bytes,1,rep,name=BearerTokenFiles,proto3" json:"BearerTokenFiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// basic auth user -> bcrypt hash of the password
+	BasicAuthUsers map[string]string `protobuf:"bytes,2,rep,name=BasicAuthUsers,proto3" json:"BasicAuthUsers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// authenticate other bearer tokens with a kubernetes TokenReview
+	KubernetesTokenReview bool `protobuf:"varint,3,opt,name=KubernetesTokenReview,proto3" json:"KubernetesTokenReview,omitempty"`
+	// per route policies, requests to routes without a policy are denied
+	Policies []*AuthPolicy `protobuf:"bytes,4,rep,name=Policies,proto3" json:"Policies,omitempty"`
+}
+
+func (x *AuthConfig) Reset() {
+	*x = AuthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthConfig) ProtoMessage() {}
+
+func (x *AuthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthConfig.ProtoReflect.Descriptor instead.
+func (*AuthConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuthConfig) GetBearerTokenFiles() map[string]string {
+	if x != nil {
+		return x.BearerTokenFiles
+	}
+	return nil
+}
+
+func (x *AuthConfig) GetBasicAuthUsers() map[string]string {
+	if x != nil {
+		return x.BasicAuthUsers
+	}
+	return nil
+}
+
+func (x *AuthConfig) GetKubernetesTokenReview() bool {
+	if x != nil {
+		return x.KubernetesTokenReview
+	}
+	return false
+}
+
+func (x *AuthConfig) GetPolicies() []*AuthPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type OTLPConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// collector endpoint, host:port for grpc or the url for http
+	// (/v1/metrics is appended when the url has no path)
+	Endpoint string `protobuf:"bytes,1,opt,name=Endpoint,proto3" json:"Endpoint,omitempty"`
+	// grpc (default) or http
+	Protocol string `protobuf:"bytes,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	// push interval in seconds, defaults to MetricsCollectionInterval
+	PushInterval uint32 `protobuf:"varint,3,opt,name=PushInterval,proto3" json:"PushInterval,omitempty"`
+	// plain text grpc/http, no TLS
+	Insecure bool `protobuf:"varint,4,opt,name=Insecure,proto3" json:"Insecure,omitempty"`
+	// CA bundle to verify the collector, system roots when not set
+	CAFile string `protobuf:"bytes,5,opt,name=CAFile,proto3" json:"CAFile,omitempty"`
+	// headers sent with every export request, e.g. authorization
+	Headers map[string]string `protobuf:"bytes,6,rep,name=Headers,proto3" json:"Headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// extra resource attributes, e.g. k8s.cluster.name
+	ResourceAttributes map[string]string `protobuf:"bytes,7,rep,name=ResourceAttributes,proto3" json:"ResourceAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *OTLPConfig) Reset() {
+	*x = OTLPConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OTLPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTLPConfig) ProtoMessage() {}
+
+func (x *OTLPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTLPConfig.ProtoReflect.Descriptor instead.
+func (*OTLPConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OTLPConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *OTLPConfig) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *OTLPConfig) GetPushInterval() uint32 {
+	if x != nil {
+		return x.PushInterval
+	}
+	return 0
+}
+
+func (x *OTLPConfig) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *OTLPConfig) GetCAFile() string {
+	if x != nil {
+		return x.CAFile
+	}
+	return ""
+}
+
+func (x *OTLPConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *OTLPConfig) GetResourceAttributes() map[string]string {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+type RemoteWriteConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// remote write endpoint, e.g. https://prometheus:9090/api/v1/write
+	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	// write interval in seconds, defaults to MetricsCollectionInterval
+	Interval uint32 `protobuf:"varint,2,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	// file holding the bearer token sent as authorization
+	BearerTokenFile string `protobuf:"bytes,3,opt,name=BearerTokenFile,proto3" json:"BearerTokenFile,omitempty"`
+	// basic auth user and the file holding its password
+	BasicAuthUser         string `protobuf:"bytes,4,opt,name=BasicAuthUser,proto3" json:"BasicAuthUser,omitempty"`
+	BasicAuthPasswordFile string `protobuf:"bytes,5,opt,name=BasicAuthPasswordFile,proto3" json:"BasicAuthPasswordFile,omitempty"`
+	// CA bundle to verify the endpoint, system roots when not set
+	CAFile string `protobuf:"bytes,6,opt,name=CAFile,proto3" json:"CAFile,omitempty"`
+	// skip verification of the endpoint certificate
+	InsecureSkipVerify bool `protobuf:"varint,7,opt,name=InsecureSkipVerify,proto3" json:"InsecureSkipVerify,omitempty"`
+	// headers sent with every write request
+	Headers map[string]string `protobuf:"bytes,8,rep,name=Headers,proto3" json:"Headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// labels added to every series, e.g. cluster
+	ExternalLabels map[string]string `protobuf:"bytes,9,rep,name=ExternalLabels,proto3" json:"ExternalLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// write ahead log directory, samples are kept here until sent
+	WALDir string `protobuf:"bytes,10,opt,name=WALDir,proto3" json:"WALDir,omitempty"`
+	// max size of the write ahead log in MB, oldest samples are dropped
+	// beyond it
+	WALMaxSizeMB uint32 `protobuf:"varint,11,opt,name=WALMaxSizeMB,proto3" json:"WALMaxSizeMB,omitempty"`
+	// max number of samples in a single write request
+	MaxSamplesPerSend uint32 `protobuf:"varint,12,opt,name=MaxSamplesPerSend,proto3" json:"MaxSamplesPerSend,omitempty"`
+}
+
+func (x *RemoteWriteConfig) Reset() {
+	*x = RemoteWriteConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteWriteConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteWriteConfig) ProtoMessage() {}
+
+func (x *RemoteWriteConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteWriteConfig.ProtoReflect.Descriptor instead.
+func (*RemoteWriteConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoteWriteConfig) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *RemoteWriteConfig) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RemoteWriteConfig) GetBearerTokenFile() string {
+	if x != nil {
+		return x.BearerTokenFile
+	}
+	return ""
+}
+
+func (x *RemoteWriteConfig) GetBasicAuthUser() string {
+	if x != nil {
+		return x.BasicAuthUser
+	}
+	return ""
+}
+
+func (x *RemoteWriteConfig) GetBasicAuthPasswordFile() string {
+	if x != nil {
+		return x.BasicAuthPasswordFile
+	}
+	return ""
+}
+
+func (x *RemoteWriteConfig) GetCAFile() string {
+	if x != nil {
+		return x.CAFile
+	}
+	return ""
+}
+
+func (x *RemoteWriteConfig) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *RemoteWriteConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RemoteWriteConfig) GetExternalLabels() map[string]string {
+	if x != nil {
+		return x.ExternalLabels
+	}
+	return nil
+}
+
+func (x *RemoteWriteConfig) GetWALDir() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.WALDir
 	}
-	return nil
+	return ""
 }
 
-func (x *GPUMetricConfig) GetExtraPodLabels() map[string]string {
+func (x *RemoteWriteConfig) GetWALMaxSizeMB() uint32 {
 	if x != nil {
-		return x.ExtraPodLabels
+		return x.WALMaxSizeMB
 	}
-	return nil
+	return 0
 }
 
-func (x *GPUMetricConfig) GetProfilerMetrics() map[string]bool {
+func (x *RemoteWriteConfig) GetMaxSamplesPerSend() uint32 {
 	if x != nil {
-		return x.ProfilerMetrics
+		return x.MaxSamplesPerSend
 	}
-	return nil
+	return 0
 }
 
-type HealthServiceConfig struct {
+type ScrapeProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// GPUMetricField names to serve, all exported fields when empty
+	Fields []string `protobuf:"bytes,1,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// GPU index selector, e.g. 0-3,6 for all the GPUs when empty
+	Selector string `protobuf:"bytes,2,opt,name=Selector,proto3" json:"Selector,omitempty"`
+	// labels to serve in addition to gpu_id, all the labels when empty
+	Labels []string `protobuf:"bytes,3,rep,name=Labels,proto3" json:"Labels,omitempty"`
 }
 
-func (x *HealthServiceConfig) Reset() {
-	*x = HealthServiceConfig{}
+func (x *ScrapeProfile) Reset() {
+	*x = ScrapeProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[2]
+		mi := &file_exporterconfig_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthServiceConfig) String() string {
+func (x *ScrapeProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthServiceConfig) ProtoMessage() {}
+func (*ScrapeProfile) ProtoMessage() {}
 
-func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[2]
+func (x *ScrapeProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,46 +1971,76 @@ func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthServiceConfig.ProtoReflect.Descriptor instead.
-func (*HealthServiceConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ScrapeProfile.ProtoReflect.Descriptor instead.
+func (*ScrapeProfile) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *HealthServiceConfig) GetEnable() bool {
+func (x *ScrapeProfile) GetFields() []string {
 	if x != nil {
-		return x.Enable
+		return x.Fields
 	}
-	return false
+	return nil
 }
 
-type CommonConfig struct {
+func (x *ScrapeProfile) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *ScrapeProfile) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// NodeOverride replaces config settings on the nodes it matches, all the
+// set matchers must match
+type NodeOverride struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// string to add as perfix to all exported fields
-	MetricsFieldPrefix string `protobuf:"bytes,1,opt,name=MetricsFieldPrefix,proto3" json:"MetricsFieldPrefix,omitempty"`
-	// Health Service config
-	HealthService *HealthServiceConfig `protobuf:"bytes,2,opt,name=HealthService,proto3" json:"HealthService,omitempty"`
-}
-
-func (x *CommonConfig) Reset() {
-	*x = CommonConfig{}
+	// name reported when the override matches, defaults to its index
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// node hostname glob, e.g. gpu-mi300-*
+	Hostname string `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	// kubernetes node label selector, e.g. pool=mi300,zone in (a,b)
+	NodeSelector string `protobuf:"bytes,3,opt,name=NodeSelector,proto3" json:"NodeSelector,omitempty"`
+	// slurm partition the node belongs to
+	SlurmPartition string `protobuf:"bytes,4,opt,name=SlurmPartition,proto3" json:"SlurmPartition,omitempty"`
+	// replaces GPUConfig.Fields when set
+	Fields []string `protobuf:"bytes,5,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// replaces GPUConfig.Labels when set
+	Labels []string `protobuf:"bytes,6,rep,name=Labels,proto3" json:"Labels,omitempty"`
+	// replaces GPUConfig.HealthThresholds when set
+	HealthThresholds *GPUHealthThresholds `protobuf:"bytes,7,opt,name=HealthThresholds,proto3" json:"HealthThresholds,omitempty"`
+	// replaces GPUConfig.CustomLabels when set
+	CustomLabels map[string]string `protobuf:"bytes,8,rep,name=CustomLabels,proto3" json:"CustomLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// replaces CommonConfig.HealthService when set
+	HealthService *HealthServiceConfig `protobuf:"bytes,9,opt,name=HealthService,proto3" json:"HealthService,omitempty"`
+}
+
+func (x *NodeOverride) Reset() {
+	*x = NodeOverride{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[3]
+		mi := &file_exporterconfig_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommonConfig) String() string {
+func (x *NodeOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommonConfig) ProtoMessage() {}
+func (*NodeOverride) ProtoMessage() {}
 
-func (x *CommonConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[3]
+func (x *NodeOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,19 +2051,68 @@ func (x *CommonConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommonConfig.ProtoReflect.Descriptor instead.
-func (*CommonConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use NodeOverride.ProtoReflect.Descriptor instead.
+func (*NodeOverride) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CommonConfig) GetMetricsFieldPrefix() string {
+func (x *NodeOverride) GetName() string {
 	if x != nil {
-		return x.MetricsFieldPrefix
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CommonConfig) GetHealthService() *HealthServiceConfig {
+func (x *NodeOverride) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *NodeOverride) GetNodeSelector() string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return ""
+}
+
+func (x *NodeOverride) GetSlurmPartition() string {
+	if x != nil {
+		return x.SlurmPartition
+	}
+	return ""
+}
+
+func (x *NodeOverride) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *NodeOverride) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NodeOverride) GetHealthThresholds() *GPUHealthThresholds {
+	if x != nil {
+		return x.HealthThresholds
+	}
+	return nil
+}
+
+func (x *NodeOverride) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *NodeOverride) GetHealthService() *HealthServiceConfig {
 	if x != nil {
 		return x.HealthService
 	}
@@ -1075,12 +2130,27 @@ type MetricConfig struct {
 	GPUConfig *GPUMetricConfig `protobuf:"bytes,2,opt,name=GPUConfig,proto3" json:"GPUConfig,omitempty"`
 	// Exporter Common Configuration
 	CommonConfig *CommonConfig `protobuf:"bytes,3,opt,name=CommonConfig,proto3" json:"CommonConfig,omitempty"`
+	// metrics server TLS config, plain HTTP is served when not set
+	TLS *TLSConfig `protobuf:"bytes,4,opt,name=TLS,proto3" json:"TLS,omitempty"`
+	// metrics server authentication, all routes are open when not set
+	Auth *AuthConfig `protobuf:"bytes,5,opt,name=Auth,proto3" json:"Auth,omitempty"`
+	// push metrics to an OpenTelemetry collector, disabled when not set
+	OTLP *OTLPConfig `protobuf:"bytes,6,opt,name=OTLP,proto3" json:"OTLP,omitempty"`
+	// remote write metrics to a prometheus endpoint, disabled when not set
+	RemoteWrite *RemoteWriteConfig `protobuf:"bytes,7,opt,name=RemoteWrite,proto3" json:"RemoteWrite,omitempty"`
+	// named subsets of the metrics served at /metrics/<name>
+	ScrapeProfiles map[string]*ScrapeProfile `protobuf:"bytes,8,rep,name=ScrapeProfiles,proto3" json:"ScrapeProfiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// per node overrides applied in order, later matches win
+	NodeOverrides []*NodeOverride `protobuf:"bytes,9,rep,name=NodeOverrides,proto3" json:"NodeOverrides,omitempty"`
+	// acknowledges serving plain HTTP when TLS is not set, a warning is
+	// logged otherwise
+	AllowPlainHTTP bool `protobuf:"varint,10,opt,name=AllowPlainHTTP,proto3" json:"AllowPlainHTTP,omitempty"`
 }
 
 func (x *MetricConfig) Reset() {
 	*x = MetricConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[4]
+		mi := &file_exporterconfig_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1093,7 +2163,7 @@ func (x *MetricConfig) String() string {
 func (*MetricConfig) ProtoMessage() {}
 
 func (x *MetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[4]
+	mi := &file_exporterconfig_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +2176,7 @@ func (x *MetricConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricConfig.ProtoReflect.Descriptor instead.
 func (*MetricConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{4}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MetricConfig) GetServerPort() uint32 {
@@ -1130,12 +2200,61 @@ func (x *MetricConfig) GetCommonConfig() *CommonConfig {
 	return nil
 }
 
+func (x *MetricConfig) GetTLS() *TLSConfig {
+	if x != nil {
+		return x.TLS
+	}
+	return nil
+}
+
+func (x *MetricConfig) GetAuth() *AuthConfig {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *MetricConfig) GetOTLP() *OTLPConfig {
+	if x != nil {
+		return x.OTLP
+	}
+	return nil
+}
+
+func (x *MetricConfig) GetRemoteWrite() *RemoteWriteConfig {
+	if x != nil {
+		return x.RemoteWrite
+	}
+	return nil
+}
+
+func (x *MetricConfig) GetScrapeProfiles() map[string]*ScrapeProfile {
+	if x != nil {
+		return x.ScrapeProfiles
+	}
+	return nil
+}
+
+func (x *MetricConfig) GetNodeOverrides() []*NodeOverride {
+	if x != nil {
+		return x.NodeOverrides
+	}
+	return nil
+}
+
+func (x *MetricConfig) GetAllowPlainHTTP() bool {
+	if x != nil {
+		return x.AllowPlainHTTP
+	}
+	return false
+}
+
 var File_exporterconfig_proto protoreflect.FileDescriptor
 
 var file_exporterconfig_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xf5, 0x07, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x48,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x99, 0x08, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x48,
 	0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12,
 	0x33, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
 	0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
@@ -1198,52 +2317,88 @@ var file_exporterconfig_proto_rawDesc = []byte{
 	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x49, 0x48, 0x12, 0x33, 0x0a, 0x16, 0x47, 0x50,
 	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
 	0x4d, 0x50, 0x49, 0x4f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x47, 0x50, 0x55, 0x45,
-	0x43, 0x43, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x4d, 0x50, 0x49, 0x4f, 0x22,
-	0x8e, 0x05, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
-	0x50, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52,
-	0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x73, 0x12, 0x56, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f,
-	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x35, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45,
+	0x43, 0x43, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x4d, 0x50, 0x49, 0x4f, 0x12,
+	0x22, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x53,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x47, 0x50, 0x55, 0x42, 0x41, 0x44, 0x50, 0x41,
+	0x47, 0x45, 0x53, 0x22, 0x9c, 0x07, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x52, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50,
+	0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5c, 0x0a,
+	0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x59, 0x0a, 0x0d,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62,
+	0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x2d, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22,
-	0x8a, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x54, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72,
+	0x6f, 0x63, 0x66, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x50, 0x72, 0x6f, 0x63, 0x66, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x79,
+	0x73, 0x66, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53,
+	0x79, 0x73, 0x66, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x47, 0x50, 0x55,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x50, 0x0a, 0x10,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x10, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0x4f,
+	0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x22,
+	0xb8, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
 	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4d, 0x65,
 	0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
@@ -1251,321 +2406,574 @@ var file_exporterconfig_proto_rawDesc = []byte{
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
 	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xb1, 0x01, 0x0a,
-	0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3e, 0x0a,
-	0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a,
-	0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2a, 0xec, 0x22, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x53,
-	0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12,
-	0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x50,
-	0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x02, 0x12, 0x18,
-	0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45,
-	0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f,
-	0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41,
-	0x54, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45,
-	0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45,
-	0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54, 0x45,
-	0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x47,
-	0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10,
-	0x07, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x4d, 0x43, 0x5f, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x4d,
-	0x4d, 0x41, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x09, 0x12, 0x14, 0x0a,
-	0x10, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
-	0x59, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x5f,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x50,
-	0x55, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f, 0x47,
-	0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0d,
-	0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x56,
-	0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x43, 0x49, 0x45,
-	0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45,
-	0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x10, 0x12, 0x12, 0x0a, 0x0e,
-	0x50, 0x43, 0x49, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x11,
-	0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x4e, 0x45, 0x52, 0x47, 0x59, 0x5f, 0x43,
-	0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x44, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x43, 0x49,
-	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x13,
-	0x12, 0x17, 0x0a, 0x13, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52,
-	0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x14, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x43, 0x49,
-	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56, 0x45,
-	0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x15, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x43, 0x49,
-	0x45, 0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e,
-	0x54, 0x10, 0x16, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43, 0x5f,
-	0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x17,
-	0x12, 0x0d, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x18, 0x12,
-	0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x41,
-	0x47, 0x45, 0x10, 0x19, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41,
-	0x4c, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x1a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41,
-	0x4c, 0x10, 0x1b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1c,
-	0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
-	0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1d, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x53, 0x44, 0x4d, 0x41, 0x10, 0x1e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
-	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x1f, 0x12,
-	0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x20, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d,
-	0x48, 0x55, 0x42, 0x10, 0x21, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
-	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42,
-	0x10, 0x22, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x23, 0x12, 0x1b, 0x0a,
-	0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x24, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x49,
-	0x46, 0x10, 0x25, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x26, 0x12, 0x17,
-	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x27, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50,
-	0x10, 0x28, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10,
-	0x29, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43,
-	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c,
-	0x10, 0x2a, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2b, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x19,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x19, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x52, 0x65, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0c,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x52, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x75, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x54,
+	0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x41, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x41, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x26, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0xbb, 0x03, 0x0a, 0x0a, 0x41, 0x75,
+	0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5d, 0x0a, 0x10, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0e, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x41, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x61, 0x73,
+	0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x34, 0x0a, 0x15, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x15, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x37, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a,
+	0x43, 0x0a, 0x15, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74,
+	0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc8, 0x03, 0x0a, 0x0a, 0x4f, 0x54, 0x4c, 0x50,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x22,
+	0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x43, 0x41, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x43, 0x41, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4f, 0x54, 0x4c, 0x50, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x63, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4f, 0x54, 0x4c, 0x50, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xa3, 0x05, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75,
+	0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x43, 0x41, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x41,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x12, 0x49, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x5e, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x57, 0x41, 0x4c, 0x44, 0x69, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x57, 0x41, 0x4c, 0x44, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x41, 0x4c, 0x4d, 0x61,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x57,
+	0x41, 0x4c, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x12, 0x2c, 0x0a, 0x11, 0x4d,
+	0x61, 0x78, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x61,
+	0x70, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xee, 0x03, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x6f,
+	0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x6c,
+	0x75, 0x72, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x53, 0x6c, 0x75, 0x72, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47,
+	0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x73, 0x52, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2, 0x05, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x47, 0x50,
+	0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x03, 0x54, 0x4c,
+	0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x03, 0x54, 0x4c, 0x53, 0x12, 0x2f, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2f, 0x0a, 0x04, 0x4f, 0x54, 0x4c,
+	0x50, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4f, 0x54, 0x4c, 0x50, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x4f, 0x54, 0x4c, 0x50, 0x12, 0x44, 0x0a, 0x0b, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x59, 0x0a, 0x0e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x53, 0x63, 0x72,
+	0x61, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x4e,
+	0x6f, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x48, 0x54,
+	0x54, 0x50, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x50,
+	0x6c, 0x61, 0x69, 0x6e, 0x48, 0x54, 0x54, 0x50, 0x1a, 0x61, 0x0a, 0x13, 0x53, 0x63, 0x72, 0x61,
+	0x70, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0xe6, 0x27, 0x0a, 0x0e,
+	0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x13,
+	0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x53, 0x5f, 0x54, 0x4f, 0x54, 0x41,
+	0x4c, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41,
+	0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50,
+	0x55, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47,
+	0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52,
+	0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x55, 0x4e, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10,
+	0x04, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f,
+	0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x05, 0x12, 0x17, 0x0a,
+	0x13, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x55, 0x52, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46,
+	0x58, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10,
+	0x47, 0x50, 0x55, 0x5f, 0x55, 0x4d, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
+	0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x4d, 0x41, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f,
+	0x56, 0x43, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0a, 0x12, 0x15,
+	0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
+	0x49, 0x54, 0x59, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x4f, 0x4c,
+	0x54, 0x41, 0x47, 0x45, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46,
+	0x58, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x47,
+	0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47,
+	0x45, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45,
+	0x44, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
+	0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x10, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f,
+	0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x47,
+	0x50, 0x55, 0x5f, 0x45, 0x4e, 0x45, 0x52, 0x47, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d,
+	0x45, 0x44, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50,
+	0x4c, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x13, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x14, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50,
+	0x4c, 0x41, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x15, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43,
+	0x4b, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x1b,
+	0x0a, 0x17, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49,
+	0x56, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x47,
+	0x50, 0x55, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x18, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x10, 0x19, 0x12,
+	0x12, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x52, 0x41,
+	0x4d, 0x10, 0x1a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1b, 0x12, 0x1b,
+	0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1c, 0x12, 0x18, 0x0a, 0x14, 0x47,
+	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53,
+	0x44, 0x4d, 0x41, 0x10, 0x1d, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10,
+	0x1e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52,
+	0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x1f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50,
 	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x44, 0x46, 0x10, 0x2c, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2d, 0x12, 0x19, 0x0a,
-	0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10,
-	0x2f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43,
-	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x30, 0x12, 0x17, 0x0a, 0x13,
-	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x4d, 0x50, 0x30, 0x10, 0x31, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
-	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x32,
-	0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
-	0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d,
-	0x50, 0x31, 0x10, 0x34, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x35, 0x12, 0x1a,
-	0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52,
-	0x45, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x36, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d,
-	0x43, 0x10, 0x37, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x38, 0x12, 0x19,
-	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30,
-	0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x39, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55,
-	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45, 0x51, 0x5f,
-	0x54, 0x58, 0x10, 0x3a, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49,
-	0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3b,
-	0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52,
-	0x5f, 0x30, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x3c, 0x12, 0x19, 0x0a,
-	0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f,
-	0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3d, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
-	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54,
-	0x58, 0x10, 0x3e, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f,
-	0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3f, 0x12,
-	0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f,
-	0x31, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x40, 0x12, 0x1c, 0x0a, 0x18,
-	0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x54,
-	0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x41, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50,
-	0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x54, 0x58, 0x5f,
-	0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x42, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f,
-	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x32, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48,
-	0x52, 0x50, 0x55, 0x54, 0x10, 0x43, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
-	0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x33, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50,
-	0x55, 0x54, 0x10, 0x44, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49,
-	0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x34, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54,
-	0x10, 0x45, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e,
-	0x42, 0x52, 0x5f, 0x35, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x46,
-	0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x52, 0x41,
-	0x4d, 0x10, 0x47, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f,
-	0x56, 0x52, 0x41, 0x4d, 0x10, 0x48, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f,
-	0x54, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d,
-	0x10, 0x49, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56,
-	0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4a, 0x12, 0x19, 0x0a,
-	0x15, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c,
-	0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4b, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f,
-	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4c, 0x12, 0x10, 0x0a, 0x0c, 0x47,
-	0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4d, 0x12, 0x10, 0x0a,
-	0x0c, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4e, 0x12,
-	0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41, 0x10, 0x4f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43,
-	0x41, 0x10, 0x50, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43,
-	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x51, 0x12, 0x19, 0x0a, 0x15,
+	0x47, 0x46, 0x58, 0x10, 0x20, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x21,
+	0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x22, 0x12, 0x19, 0x0a,
+	0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x23, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54,
+	0x48, 0x55, 0x42, 0x10, 0x24, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x25, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x26, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50,
+	0x10, 0x27, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e,
+	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x28, 0x12, 0x1d, 0x0a,
+	0x19, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x29, 0x12, 0x1f, 0x0a, 0x1b,
 	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x52, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10,
-	0x53, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43,
-	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x54, 0x12, 0x16, 0x0a,
+	0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x2a, 0x12, 0x16, 0x0a,
 	0x12, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x5f, 0x49, 0x48, 0x10, 0x55, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
-	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x56, 0x12,
+	0x5f, 0x44, 0x46, 0x10, 0x2b, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2c, 0x12,
+	0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2d, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d,
+	0x4e, 0x10, 0x2e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x2f, 0x12, 0x19, 0x0a, 0x15,
+	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
+	0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x30, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x31,
+	0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x32, 0x12, 0x17, 0x0a, 0x13, 0x47,
+	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d,
+	0x50, 0x31, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x34, 0x12,
 	0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x57, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d,
-	0x50, 0x49, 0x4f, 0x10, 0x58, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x45, 0x41,
-	0x4c, 0x54, 0x48, 0x10, 0x59, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d,
-	0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x58, 0x10, 0x5a, 0x12, 0x14, 0x0a, 0x10, 0x47,
-	0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x58, 0x10,
-	0x5b, 0x12, 0x2d, 0x0a, 0x29, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d,
-	0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x5c,
-	0x12, 0x35, 0x0a, 0x31, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4f, 0x52, 0x5f, 0x48, 0x4f, 0x54, 0x5f,
+	0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x35, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46,
+	0x55, 0x53, 0x45, 0x10, 0x36, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x37, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x38, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55,
+	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x4e, 0x4f, 0x50, 0x5f,
+	0x54, 0x58, 0x10, 0x39, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49,
+	0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x10, 0x3a, 0x12,
+	0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f,
+	0x30, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3b, 0x12, 0x1b, 0x0a, 0x17, 0x47,
+	0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x42, 0x45,
+	0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x3c, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
+	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54,
+	0x58, 0x10, 0x3d, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f,
+	0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x10, 0x3e, 0x12, 0x1a,
+	0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31,
+	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3f, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50,
+	0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x42, 0x45, 0x41,
+	0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x40, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58,
+	0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52,
+	0x50, 0x55, 0x54, 0x10, 0x41, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d,
+	0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55,
+	0x54, 0x10, 0x42, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f,
+	0x4e, 0x42, 0x52, 0x5f, 0x32, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10,
+	0x43, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42,
+	0x52, 0x5f, 0x33, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x44, 0x12,
+	0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f,
+	0x34, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x45, 0x12, 0x1c, 0x0a,
+	0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x35, 0x5f,
+	0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x46, 0x12, 0x11, 0x0a, 0x0d, 0x47,
+	0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x47, 0x12, 0x11,
+	0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10,
+	0x48, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56,
+	0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x49, 0x12, 0x19, 0x0a,
+	0x15, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c,
+	0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
+	0x46, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41,
+	0x4d, 0x10, 0x4b, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
+	0x5f, 0x47, 0x54, 0x54, 0x10, 0x4c, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53,
+	0x45, 0x44, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4d, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f,
+	0x46, 0x52, 0x45, 0x45, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43,
+	0x41, 0x10, 0x4f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
+	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41, 0x10, 0x50, 0x12, 0x17,
+	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
+	0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x51, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e,
+	0x10, 0x52, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x53, 0x12, 0x1a, 0x0a, 0x16,
+	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
+	0x54, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x54, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x55,
+	0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x56, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50,
+	0x49, 0x4f, 0x10, 0x57, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x58,
+	0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x59,
+	0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x52, 0x58, 0x10, 0x5a, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
+	0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x58, 0x10, 0x5b, 0x12, 0x2d, 0x0a, 0x29,
+	0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55,
+	0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45,
+	0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x5c, 0x12, 0x35, 0x0a, 0x31, 0x47,
+	0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
+	0x43, 0x45, 0x53, 0x53, 0x4f, 0x52, 0x5f, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x5d, 0x12, 0x2b, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x50, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43,
+	0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5e, 0x12,
+	0x36, 0x0a, 0x32, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f,
 	0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55,
-	0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5d, 0x12, 0x2b, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x56,
-	0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x50, 0x54, 0x5f, 0x52, 0x45, 0x53,
-	0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54,
-	0x45, 0x44, 0x10, 0x5e, 0x12, 0x36, 0x0a, 0x32, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x48, 0x45,
-	0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41,
-	0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5f, 0x12, 0x32, 0x0a, 0x2e,
-	0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52,
+	0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5f, 0x12, 0x32, 0x0a, 0x2e, 0x47, 0x50, 0x55, 0x5f, 0x56,
+	0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x52,
+	0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43,
+	0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x60, 0x12, 0x33, 0x0a, 0x2f, 0x47,
+	0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x42, 0x4d,
 	0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e,
-	0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x60,
-	0x12, 0x33, 0x0a, 0x2f, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45,
-	0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41,
-	0x54, 0x45, 0x44, 0x10, 0x61, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58,
-	0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45,
-	0x4f, 0x55, 0x53, 0x10, 0x62, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e,
-	0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45,
-	0x4f, 0x55, 0x53, 0x10, 0x63, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45,
-	0x47, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e,
-	0x45, 0x4f, 0x55, 0x53, 0x10, 0x64, 0x12, 0x1d, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x47, 0x55, 0x49, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x56, 0x45, 0x10, 0xa1, 0x06, 0x12, 0x16, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x53, 0x51, 0x5f, 0x57, 0x41, 0x56, 0x45, 0x53, 0x10, 0xa2, 0x06, 0x12, 0x18, 0x0a,
-	0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x43,
-	0x4f, 0x55, 0x4e, 0x54, 0x10, 0xa3, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa4, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa5, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xa6, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47,
-	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f,
-	0x54, 0x43, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa7, 0x06, 0x12, 0x1f, 0x0a, 0x1a,
+	0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x61,
+	0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x42, 0x55, 0x53, 0x59,
+	0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x62,
+	0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e, 0x5f, 0x42, 0x55, 0x53, 0x59,
+	0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x63,
+	0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x5f, 0x42, 0x55, 0x53,
+	0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10,
+	0x64, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x41, 0x4e, 0x5f, 0x53, 0x50, 0x45,
+	0x45, 0x44, 0x10, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x66, 0x12, 0x23, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45,
+	0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x43,
+	0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x50, 0x55, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0x68, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x69, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0x6a, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
+	0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x6b, 0x12,
+	0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x6c, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x43, 0x49, 0x45,
+	0x5f, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x6d, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45,
+	0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x6e, 0x12, 0x12, 0x0a, 0x0e,
+	0x50, 0x43, 0x49, 0x45, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x6f,
+	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x70, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x49, 0x52, 0x4d, 0x57, 0x41,
+	0x52, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x71, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55,
+	0x5f, 0x48, 0x41, 0x52, 0x44, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x72,
+	0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x50, 0x41, 0x47, 0x45,
+	0x53, 0x10, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45,
+	0x53, 0x53, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x74, 0x12, 0x1a,
+	0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x44,
+	0x4d, 0x41, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x10, 0x75, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x55, 0x5f, 0x4f, 0x43, 0x43,
+	0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x10, 0x76, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f,
+	0x44, 0x45, 0x52, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x77, 0x12,
+	0x20, 0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x44, 0x45, 0x52, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x50,
+	0x43, 0x49, 0x45, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x78, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x44, 0x45, 0x52, 0x49, 0x56, 0x45, 0x44,
+	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x44, 0x45, 0x52, 0x49, 0x56,
+	0x45, 0x44, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x52,
+	0x41, 0x54, 0x45, 0x10, 0x7a, 0x12, 0x22, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x44, 0x45, 0x52,
+	0x49, 0x56, 0x45, 0x44, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x43, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x7b, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x50, 0x55,
+	0x5f, 0x44, 0x45, 0x52, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x41, 0x59, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x7c, 0x12, 0x1d, 0x0a, 0x18, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x47, 0x55, 0x49,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0xa1, 0x06, 0x12, 0x16, 0x0a, 0x11, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x51, 0x5f, 0x57, 0x41, 0x56, 0x45, 0x53, 0x10,
+	0xa2, 0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47,
+	0x52, 0x42, 0x4d, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xa3, 0x06, 0x12, 0x1f, 0x0a, 0x1a,
 	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43,
-	0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa8, 0x06, 0x12, 0x22, 0x0a,
-	0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50,
-	0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa9,
-	0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
-	0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x49, 0x44,
-	0x4c, 0x45, 0x10, 0xaa, 0x06, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49,
-	0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xab, 0x06, 0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45, 0x31, 0x5f, 0x42,
-	0x55, 0x53, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x44,
-	0x45, 0x43, 0x4f, 0x44, 0x45, 0x10, 0xac, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45, 0x31, 0x5f, 0x44, 0x43, 0x30,
-	0x5f, 0x53, 0x50, 0x49, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xad, 0x06, 0x12, 0x2c, 0x0a, 0x27,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43,
-	0x4c, 0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59,
-	0x53, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xaf, 0x06, 0x12, 0x2b, 0x0a, 0x26, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
-	0x56, 0x41, 0x49, 0x4c, 0x10, 0xb0, 0x06, 0x12, 0x29, 0x0a, 0x24, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x44, 0x49, 0x53, 0x50,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10,
-	0xb1, 0x06, 0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x55,
-	0x4e, 0x4b, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0xb2, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46,
-	0x49, 0x46, 0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x10, 0xb3,
-	0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
-	0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c,
-	0x10, 0xb4, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x43, 0x50, 0x43, 0x5f, 0x47, 0x44, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xb5, 0x06, 0x12, 0x19,
-	0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54,
-	0x47, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xb6, 0x06, 0x12, 0x21, 0x0a, 0x1c, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x57, 0x41, 0x4c, 0x4b, 0x5f, 0x4e,
-	0x45, 0x58, 0x54, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x10, 0xb7, 0x06, 0x12, 0x24, 0x0a, 0x1f,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41,
-	0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x30, 0x5f, 0x53, 0x50, 0x49, 0x10,
-	0xb8, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45,
-	0x31, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xb9, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44,
-	0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x32, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xba, 0x06, 0x12, 0x24,
-	0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53,
-	0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x33, 0x5f, 0x53, 0x50,
-	0x49, 0x10, 0xbb, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4c, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0xbc, 0x06, 0x12,
-	0x26, 0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x57, 0x52, 0x52, 0x45, 0x51, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f,
-	0x42, 0x55, 0x53, 0x59, 0x10, 0xbd, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x42, 0x55, 0x53,
-	0x59, 0x10, 0xbe, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10,
-	0xbf, 0x06, 0x12, 0x30, 0x0a, 0x2b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x46, 0x5f, 0x43, 0x4d, 0x50, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31, 0x5f, 0x53, 0x54, 0x41,
-	0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0xc0, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x42, 0x55,
-	0x53, 0x59, 0x10, 0xc1, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x49,
-	0x44, 0x4c, 0x45, 0x10, 0xc2, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc3, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49,
-	0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xc4, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43,
-	0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc5, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54,
-	0x43, 0x49, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc6, 0x06, 0x12, 0x18, 0x0a, 0x13,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x53,
-	0x49, 0x5a, 0x45, 0x10, 0xe8, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x10, 0xe9, 0x07,
-	0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x5f, 0x31, 0x36, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xea, 0x07, 0x12, 0x1a, 0x0a, 0x15,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x33,
-	0x32, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x36, 0x34, 0x5f, 0x4f, 0x50,
-	0x53, 0x10, 0xec, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x47, 0x55, 0x49, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e,
-	0x54, 0x10, 0xed, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45,
-	0x4e, 0x54, 0x10, 0xee, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x54, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f,
-	0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xef, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x5f, 0x50, 0x49, 0x50, 0x45,
-	0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x10, 0xf0, 0x07, 0x12, 0x17,
-	0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x4d, 0x5f, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x10, 0xf1, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x4c,
-	0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0xf2, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50,
-	0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x55, 0x10, 0xf3, 0x07, 0x2a,
-	0xdf, 0x02, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x00,
-	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45,
-	0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x50, 0x55, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x41, 0x4d, 0x45,
-	0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41,
-	0x49, 0x4e, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x44,
-	0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x07,
-	0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4e,
-	0x41, 0x4d, 0x45, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45,
-	0x52, 0x49, 0x45, 0x53, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x56,
-	0x45, 0x4e, 0x44, 0x4f, 0x52, 0x10, 0x0c, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x52, 0x49, 0x56, 0x45,
-	0x52, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x11, 0x0a, 0x0d, 0x56,
-	0x42, 0x49, 0x4f, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0e, 0x12, 0x0c,
-	0x0a, 0x08, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x0f, 0x12, 0x14, 0x0a, 0x10,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44,
-	0x10, 0x10, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x55, 0x54,
-	0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x10, 0x11, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59,
-	0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
-	0x12, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa4, 0x06, 0x12, 0x1f, 0x0a,
+	0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa5, 0x06, 0x12, 0x20,
+	0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43,
+	0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xa6, 0x06,
+	0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43,
+	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa7,
+	0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10,
+	0xa8, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
+	0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x42,
+	0x55, 0x53, 0x59, 0x10, 0xa9, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32,
+	0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xaa, 0x06, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55,
+	0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xab, 0x06, 0x12,
+	0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
+	0x4d, 0x45, 0x31, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x4f, 0x44, 0x45, 0x10, 0xac, 0x06, 0x12, 0x22, 0x0a,
+	0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45,
+	0x31, 0x5f, 0x44, 0x43, 0x30, 0x5f, 0x53, 0x50, 0x49, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xad,
+	0x06, 0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x06, 0x12,
+	0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
+	0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xaf, 0x06, 0x12,
+	0x2b, 0x0a, 0x26, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
+	0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x10, 0xb0, 0x06, 0x12, 0x29, 0x0a, 0x24,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43,
+	0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f,
+	0x44, 0x4f, 0x4e, 0x45, 0x10, 0xb1, 0x06, 0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0xb2, 0x06, 0x12, 0x26,
+	0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x10, 0xb3, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f,
+	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb4, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x47, 0x44, 0x5f, 0x42, 0x55, 0x53, 0x59,
+	0x10, 0xb5, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x43, 0x50, 0x43, 0x5f, 0x54, 0x47, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xb6, 0x06, 0x12, 0x21,
+	0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x57,
+	0x41, 0x4c, 0x4b, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x10, 0xb7,
+	0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x30,
+	0x5f, 0x53, 0x50, 0x49, 0x10, 0xb8, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f,
+	0x42, 0x59, 0x5f, 0x53, 0x45, 0x31, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xb9, 0x06, 0x12, 0x24, 0x0a,
+	0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x32, 0x5f, 0x53, 0x50, 0x49,
+	0x10, 0xba, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53,
+	0x45, 0x33, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xbb, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4c, 0x54, 0x45, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0xbc, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x57, 0x52, 0x52, 0x45, 0x51, 0x5f,
+	0x46, 0x49, 0x46, 0x4f, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbd, 0x06, 0x12, 0x1b, 0x0a, 0x16,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e,
+	0x45, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbe, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x4c, 0x4c, 0x10, 0xbf, 0x06, 0x12, 0x30, 0x0a, 0x2b, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x4d, 0x50, 0x5f, 0x55, 0x54, 0x43, 0x4c,
+	0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc0, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xc1, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc2, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc3, 0x06, 0x12, 0x1f, 0x0a,
+	0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50,
+	0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xc4, 0x06, 0x12, 0x1f,
+	0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43,
+	0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc5, 0x06, 0x12,
+	0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f,
+	0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc6,
+	0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x46, 0x45,
+	0x54, 0x43, 0x48, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x10, 0xe8, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x49,
+	0x5a, 0x45, 0x10, 0xe9, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
+	0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x31, 0x36, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xea,
+	0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f,
+	0x54, 0x41, 0x4c, 0x5f, 0x33, 0x32, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x1a, 0x0a,
+	0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f,
+	0x36, 0x34, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xec, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x55, 0x49, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xed, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f,
+	0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xee, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xef, 0x07, 0x12,
+	0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x56, 0x41, 0x4c, 0x55,
+	0x5f, 0x50, 0x49, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x55, 0x54, 0x49, 0x4c,
+	0x10, 0xf0, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x53, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0xf1, 0x07, 0x12, 0x1f, 0x0a, 0x1a,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e,
+	0x43, 0x59, 0x5f, 0x45, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0xf2, 0x07, 0x12, 0x25, 0x0a,
+	0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41,
+	0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x43,
+	0x55, 0x10, 0xf3, 0x07, 0x2a, 0xdf, 0x02, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x5f, 0x55,
+	0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f,
+	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x50, 0x55, 0x5f,
+	0x49, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4a,
+	0x4f, 0x42, 0x5f, 0x49, 0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x55, 0x53,
+	0x54, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41,
+	0x52, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x43,
+	0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x43,
+	0x41, 0x52, 0x44, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x10, 0x0c, 0x12, 0x12, 0x0a, 0x0e,
+	0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0d,
+	0x12, 0x11, 0x0a, 0x0d, 0x56, 0x42, 0x49, 0x4f, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x0e, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45, 0x10,
+	0x0f, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x10, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x11, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x4d,
+	0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x10, 0x12, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1581,32 +2989,73 @@ func file_exporterconfig_proto_rawDescGZIP() []byte {
 }
 
 var file_exporterconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_exporterconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_exporterconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_exporterconfig_proto_goTypes = []any{
-	(GPUMetricField)(0),         // 0: exportermetrics.GPUMetricField
-	(GPUMetricLabel)(0),         // 1: exportermetrics.GPUMetricLabel
-	(*GPUHealthThresholds)(nil), // 2: exportermetrics.GPUHealthThresholds
-	(*GPUMetricConfig)(nil),     // 3: exportermetrics.GPUMetricConfig
-	(*HealthServiceConfig)(nil), // 4: exportermetrics.HealthServiceConfig
-	(*CommonConfig)(nil),        // 5: exportermetrics.CommonConfig
-	(*MetricConfig)(nil),        // 6: exportermetrics.MetricConfig
-	nil,                         // 7: exportermetrics.GPUMetricConfig.CustomLabelsEntry
-	nil,                         // 8: exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
-	nil,                         // 9: exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
+	(GPUMetricField)(0),          // 0: exportermetrics.GPUMetricField
+	(GPUMetricLabel)(0),          // 1: exportermetrics.GPUMetricLabel
+	(*GPUHealthThresholds)(nil),  // 2: exportermetrics.GPUHealthThresholds
+	(*GPUMetricConfig)(nil),      // 3: exportermetrics.GPUMetricConfig
+	(*ProcessMetricsConfig)(nil), // 4: exportermetrics.ProcessMetricsConfig
+	(*GPUModelProfile)(nil),      // 5: exportermetrics.GPUModelProfile
+	(*HealthServiceConfig)(nil),  // 6: exportermetrics.HealthServiceConfig
+	(*CommonConfig)(nil),         // 7: exportermetrics.CommonConfig
+	(*RelabelConfig)(nil),        // 8: exportermetrics.RelabelConfig
+	(*TLSConfig)(nil),            // 9: exportermetrics.TLSConfig
+	(*AuthPolicy)(nil),           // 10: exportermetrics.AuthPolicy
+	(*AuthConfig)(nil),           // 11: exportermetrics.AuthConfig
+	(*OTLPConfig)(nil),           // 12: exportermetrics.OTLPConfig
+	(*RemoteWriteConfig)(nil),    // 13: exportermetrics.RemoteWriteConfig
+	(*ScrapeProfile)(nil),        // 14: exportermetrics.ScrapeProfile
+	(*NodeOverride)(nil),         // 15: exportermetrics.NodeOverride
+	(*MetricConfig)(nil),         // 16: exportermetrics.MetricConfig
+	nil,                          // 17: exportermetrics.GPUMetricConfig.CustomLabelsEntry
+	nil,                          // 18: exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
+	nil,                          // 19: exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
+	nil,                          // 20: exportermetrics.GPUMetricConfig.ModelProfilesEntry
+	nil,                          // 21: exportermetrics.AuthConfig.BearerTokenFilesEntry
+	nil,                          // 22: exportermetrics.AuthConfig.BasicAuthUsersEntry
+	nil,                          // 23: exportermetrics.OTLPConfig.HeadersEntry
+	nil,                          // 24: exportermetrics.OTLPConfig.ResourceAttributesEntry
+	nil,                          // 25: exportermetrics.RemoteWriteConfig.HeadersEntry
+	nil,                          // 26: exportermetrics.RemoteWriteConfig.ExternalLabelsEntry
+	nil,                          // 27: exportermetrics.NodeOverride.CustomLabelsEntry
+	nil,                          // 28: exportermetrics.MetricConfig.ScrapeProfilesEntry
 }
 var file_exporterconfig_proto_depIdxs = []int32{
-	2, // 0: exportermetrics.GPUMetricConfig.HealthThresholds:type_name -> exportermetrics.GPUHealthThresholds
-	7, // 1: exportermetrics.GPUMetricConfig.CustomLabels:type_name -> exportermetrics.GPUMetricConfig.CustomLabelsEntry
-	8, // 2: exportermetrics.GPUMetricConfig.ExtraPodLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
-	9, // 3: exportermetrics.GPUMetricConfig.ProfilerMetrics:type_name -> exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
-	4, // 4: exportermetrics.CommonConfig.HealthService:type_name -> exportermetrics.HealthServiceConfig
-	3, // 5: exportermetrics.MetricConfig.GPUConfig:type_name -> exportermetrics.GPUMetricConfig
-	5, // 6: exportermetrics.MetricConfig.CommonConfig:type_name -> exportermetrics.CommonConfig
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: exportermetrics.GPUMetricConfig.HealthThresholds:type_name -> exportermetrics.GPUHealthThresholds
+	17, // 1: exportermetrics.GPUMetricConfig.CustomLabels:type_name -> exportermetrics.GPUMetricConfig.CustomLabelsEntry
+	18, // 2: exportermetrics.GPUMetricConfig.ExtraPodLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
+	19, // 3: exportermetrics.GPUMetricConfig.ProfilerMetrics:type_name -> exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
+	20, // 4: exportermetrics.GPUMetricConfig.ModelProfiles:type_name -> exportermetrics.GPUMetricConfig.ModelProfilesEntry
+	4,  // 5: exportermetrics.GPUMetricConfig.ProcessMetrics:type_name -> exportermetrics.ProcessMetricsConfig
+	2,  // 6: exportermetrics.GPUModelProfile.HealthThresholds:type_name -> exportermetrics.GPUHealthThresholds
+	6,  // 7: exportermetrics.CommonConfig.HealthService:type_name -> exportermetrics.HealthServiceConfig
+	8,  // 8: exportermetrics.CommonConfig.RelabelConfigs:type_name -> exportermetrics.RelabelConfig
+	21, // 9: exportermetrics.AuthConfig.BearerTokenFiles:type_name -> exportermetrics.AuthConfig.BearerTokenFilesEntry
+	22, // 10: exportermetrics.AuthConfig.BasicAuthUsers:type_name -> exportermetrics.AuthConfig.BasicAuthUsersEntry
+	10, // 11: exportermetrics.AuthConfig.Policies:type_name -> exportermetrics.AuthPolicy
+	23, // 12: exportermetrics.OTLPConfig.Headers:type_name -> exportermetrics.OTLPConfig.HeadersEntry
+	24, // 13: exportermetrics.OTLPConfig.ResourceAttributes:type_name -> exportermetrics.OTLPConfig.ResourceAttributesEntry
+	25, // 14: exportermetrics.RemoteWriteConfig.Headers:type_name -> exportermetrics.RemoteWriteConfig.HeadersEntry
+	26, // 15: exportermetrics.RemoteWriteConfig.ExternalLabels:type_name -> exportermetrics.RemoteWriteConfig.ExternalLabelsEntry
+	2,  // 16: exportermetrics.NodeOverride.HealthThresholds:type_name -> exportermetrics.GPUHealthThresholds
+	27, // 17: exportermetrics.NodeOverride.CustomLabels:type_name -> exportermetrics.NodeOverride.CustomLabelsEntry
+	6,  // 18: exportermetrics.NodeOverride.HealthService:type_name -> exportermetrics.HealthServiceConfig
+	3,  // 19: exportermetrics.MetricConfig.GPUConfig:type_name -> exportermetrics.GPUMetricConfig
+	7,  // 20: exportermetrics.MetricConfig.CommonConfig:type_name -> exportermetrics.CommonConfig
+	9,  // 21: exportermetrics.MetricConfig.TLS:type_name -> exportermetrics.TLSConfig
+	11, // 22: exportermetrics.MetricConfig.Auth:type_name -> exportermetrics.AuthConfig
+	12, // 23: exportermetrics.MetricConfig.OTLP:type_name -> exportermetrics.OTLPConfig
+	13, // 24: exportermetrics.MetricConfig.RemoteWrite:type_name -> exportermetrics.RemoteWriteConfig
+	28, // 25: exportermetrics.MetricConfig.ScrapeProfiles:type_name -> exportermetrics.MetricConfig.ScrapeProfilesEntry
+	15, // 26: exportermetrics.MetricConfig.NodeOverrides:type_name -> exportermetrics.NodeOverride
+	5,  // 27: exportermetrics.GPUMetricConfig.ModelProfilesEntry.value:type_name -> exportermetrics.GPUModelProfile
+	14, // 28: exportermetrics.MetricConfig.ScrapeProfilesEntry.value:type_name -> exportermetrics.ScrapeProfile
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_exporterconfig_proto_init() }
@@ -1640,7 +3089,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*HealthServiceConfig); i {
+			switch v := v.(*ProcessMetricsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1652,7 +3101,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*CommonConfig); i {
+			switch v := v.(*GPUModelProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1664,6 +3113,126 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthServiceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CommonConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*RelabelConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AuthPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*AuthConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*OTLPConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoteWriteConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ScrapeProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*NodeOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*MetricConfig); i {
 			case 0:
 				return &v.state
@@ -1682,7 +3251,7 @@ func file_exporterconfig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_exporterconfig_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   8,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -107,6 +107,11 @@ type GPUState struct {
 	AssociatedWorkload []string `protobuf:"bytes,4,rep,name=AssociatedWorkload,proto3" json:"AssociatedWorkload,omitempty"`
 	// PCIe Bus ID refers to device ID in amd device plugin
 	Device string `protobuf:"bytes,5,opt,name=Device,proto3" json:"Device,omitempty"`
+	// why the GPU is unhealthy, empty when healthy
+	HealthReasons []string `protobuf:"bytes,6,rep,name=HealthReasons,proto3" json:"HealthReasons,omitempty"`
+	// counts of the fields which crossed their health threshold by field
+	// name, a change of the counts alone is not sent to the watchers
+	ErrorCounts map[string]uint64 `protobuf:"bytes,7,rep,name=ErrorCounts,proto3" json:"ErrorCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *GPUState) Reset() {
@@ -176,6 +181,20 @@ func (x *GPUState) GetDevice() string {
 	return ""
 }
 
+func (x *GPUState) GetHealthReasons() []string {
+	if x != nil {
+		return x.HealthReasons
+	}
+	return nil
+}
+
+func (x *GPUState) GetErrorCounts() map[string]uint64 {
+	if x != nil {
+		return x.ErrorCounts
+	}
+	return nil
+}
+
 type GPUGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -329,6 +348,74 @@ func (x *GPUStateResponse) GetGPUState() []*GPUState {
 	return nil
 }
 
+type GPUStateWatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true for the full state of the watched GPUs, sent first and again
+	// when the deltas of a slow consumer were dropped
+	Snapshot bool `protobuf:"varint,1,opt,name=Snapshot,proto3" json:"Snapshot,omitempty"`
+	// watched GPUs whose state changed, all of them in a snapshot
+	GPUState []*GPUState `protobuf:"bytes,2,rep,name=GPUState,proto3" json:"GPUState,omitempty"`
+	// ids of the watched GPUs no longer reported, e.g. after a driver
+	// reload, only set in the deltas
+	RemovedID []string `protobuf:"bytes,3,rep,name=RemovedID,proto3" json:"RemovedID,omitempty"`
+}
+
+func (x *GPUStateWatchResponse) Reset() {
+	*x = GPUStateWatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metricssvc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPUStateWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUStateWatchResponse) ProtoMessage() {}
+
+func (x *GPUStateWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metricssvc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUStateWatchResponse.ProtoReflect.Descriptor instead.
+func (*GPUStateWatchResponse) Descriptor() ([]byte, []int) {
+	return file_metricssvc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GPUStateWatchResponse) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *GPUStateWatchResponse) GetGPUState() []*GPUState {
+	if x != nil {
+		return x.GPUState
+	}
+	return nil
+}
+
+func (x *GPUStateWatchResponse) GetRemovedID() []string {
+	if x != nil {
+		return x.RemovedID
+	}
+	return nil
+}
+
 // only for testing ecc error simulation
 type GPUErrorRequest struct {
 	state         protoimpl.MessageState
@@ -346,7 +433,7 @@ type GPUErrorRequest struct {
 func (x *GPUErrorRequest) Reset() {
 	*x = GPUErrorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_metricssvc_proto_msgTypes[4]
+		mi := &file_metricssvc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -359,7 +446,7 @@ func (x *GPUErrorRequest) String() string {
 func (*GPUErrorRequest) ProtoMessage() {}
 
 func (x *GPUErrorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_metricssvc_proto_msgTypes[4]
+	mi := &file_metricssvc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +459,7 @@ func (x *GPUErrorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUErrorRequest.ProtoReflect.Descriptor instead.
 func (*GPUErrorRequest) Descriptor() ([]byte, []int) {
-	return file_metricssvc_proto_rawDescGZIP(), []int{4}
+	return file_metricssvc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GPUErrorRequest) GetID() string {
@@ -408,7 +495,7 @@ type GPUErrorResponse struct {
 func (x *GPUErrorResponse) Reset() {
 	*x = GPUErrorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_metricssvc_proto_msgTypes[5]
+		mi := &file_metricssvc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -421,7 +508,7 @@ func (x *GPUErrorResponse) String() string {
 func (*GPUErrorResponse) ProtoMessage() {}
 
 func (x *GPUErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_metricssvc_proto_msgTypes[5]
+	mi := &file_metricssvc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +521,7 @@ func (x *GPUErrorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUErrorResponse.ProtoReflect.Descriptor instead.
 func (*GPUErrorResponse) Descriptor() ([]byte, []int) {
-	return file_metricssvc_proto_rawDescGZIP(), []int{5}
+	return file_metricssvc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GPUErrorResponse) GetID() string {
@@ -457,7 +544,7 @@ var file_metricssvc_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x1a, 0x1b,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x08,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x02, 0x0a, 0x08,
 	0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
@@ -466,46 +553,70 @@ var file_metricssvc_proto_rawDesc = []byte{
 	0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
 	0x52, 0x12, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b,
 	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x1f, 0x0a, 0x0d,
-	0x47, 0x50, 0x55, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x3a, 0x0a,
-	0x10, 0x47, 0x50, 0x55, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x44, 0x0a, 0x10, 0x47, 0x50, 0x55,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
-	0x51, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2a, 0x34,
-	0x0a, 0x09, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c,
-	0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54,
-	0x48, 0x59, 0x10, 0x02, 0x32, 0xe3, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x50,
-	0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47,
-	0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47,
-	0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x2e,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e, 0x67, 0x65,
-	0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x0d, 0x47,
+	0x50, 0x55, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x3a, 0x0a, 0x10,
+	0x47, 0x50, 0x55, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x44, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x83,
+	0x01, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x47, 0x50,
+	0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x49, 0x44, 0x22, 0x51, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x2a, 0x34, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x32, 0xb6, 0x02, 0x0a, 0x0e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e,
+	0x47, 0x50, 0x55, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50,
+	0x55, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x42, 0x10, 0x5a, 0x0e, 0x67, 0x65, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -521,30 +632,36 @@ func file_metricssvc_proto_rawDescGZIP() []byte {
 }
 
 var file_metricssvc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_metricssvc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_metricssvc_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_metricssvc_proto_goTypes = []any{
-	(GPUHealth)(0),           // 0: metricssvc.GPUHealth
-	(*GPUState)(nil),         // 1: metricssvc.GPUState
-	(*GPUGetRequest)(nil),    // 2: metricssvc.GPUGetRequest
-	(*GPUUpdateRequest)(nil), // 3: metricssvc.GPUUpdateRequest
-	(*GPUStateResponse)(nil), // 4: metricssvc.GPUStateResponse
-	(*GPUErrorRequest)(nil),  // 5: metricssvc.GPUErrorRequest
-	(*GPUErrorResponse)(nil), // 6: metricssvc.GPUErrorResponse
-	(*empty.Empty)(nil),      // 7: google.protobuf.Empty
+	(GPUHealth)(0),                // 0: metricssvc.GPUHealth
+	(*GPUState)(nil),              // 1: metricssvc.GPUState
+	(*GPUGetRequest)(nil),         // 2: metricssvc.GPUGetRequest
+	(*GPUUpdateRequest)(nil),      // 3: metricssvc.GPUUpdateRequest
+	(*GPUStateResponse)(nil),      // 4: metricssvc.GPUStateResponse
+	(*GPUStateWatchResponse)(nil), // 5: metricssvc.GPUStateWatchResponse
+	(*GPUErrorRequest)(nil),       // 6: metricssvc.GPUErrorRequest
+	(*GPUErrorResponse)(nil),      // 7: metricssvc.GPUErrorResponse
+	nil,                           // 8: metricssvc.GPUState.ErrorCountsEntry
+	(*empty.Empty)(nil),           // 9: google.protobuf.Empty
 }
 var file_metricssvc_proto_depIdxs = []int32{
-	1, // 0: metricssvc.GPUStateResponse.GPUState:type_name -> metricssvc.GPUState
-	2, // 1: metricssvc.MetricsService.GetGPUState:input_type -> metricssvc.GPUGetRequest
-	7, // 2: metricssvc.MetricsService.List:input_type -> google.protobuf.Empty
-	5, // 3: metricssvc.MetricsService.SetError:input_type -> metricssvc.GPUErrorRequest
-	4, // 4: metricssvc.MetricsService.GetGPUState:output_type -> metricssvc.GPUStateResponse
-	4, // 5: metricssvc.MetricsService.List:output_type -> metricssvc.GPUStateResponse
-	6, // 6: metricssvc.MetricsService.SetError:output_type -> metricssvc.GPUErrorResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8, // 0: metricssvc.GPUState.ErrorCounts:type_name -> metricssvc.GPUState.ErrorCountsEntry
+	1, // 1: metricssvc.GPUStateResponse.GPUState:type_name -> metricssvc.GPUState
+	1, // 2: metricssvc.GPUStateWatchResponse.GPUState:type_name -> metricssvc.GPUState
+	2, // 3: metricssvc.MetricsService.GetGPUState:input_type -> metricssvc.GPUGetRequest
+	9, // 4: metricssvc.MetricsService.List:input_type -> google.protobuf.Empty
+	6, // 5: metricssvc.MetricsService.SetError:input_type -> metricssvc.GPUErrorRequest
+	2, // 6: metricssvc.MetricsService.WatchGPUState:input_type -> metricssvc.GPUGetRequest
+	4, // 7: metricssvc.MetricsService.GetGPUState:output_type -> metricssvc.GPUStateResponse
+	4, // 8: metricssvc.MetricsService.List:output_type -> metricssvc.GPUStateResponse
+	7, // 9: metricssvc.MetricsService.SetError:output_type -> metricssvc.GPUErrorResponse
+	5, // 10: metricssvc.MetricsService.WatchGPUState:output_type -> metricssvc.GPUStateWatchResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_metricssvc_proto_init() }
@@ -602,7 +719,7 @@ func file_metricssvc_proto_init() {
 			}
 		}
 		file_metricssvc_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GPUErrorRequest); i {
+			switch v := v.(*GPUStateWatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -614,6 +731,18 @@ func file_metricssvc_proto_init() {
 			}
 		}
 		file_metricssvc_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GPUErrorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metricssvc_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*GPUErrorResponse); i {
 			case 0:
 				return &v.state
@@ -632,7 +761,7 @@ func file_metricssvc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_metricssvc_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -42,9 +42,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetricsService_GetGPUState_FullMethodName = "/metricssvc.MetricsService/GetGPUState"
-	MetricsService_List_FullMethodName        = "/metricssvc.MetricsService/List"
-	MetricsService_SetError_FullMethodName    = "/metricssvc.MetricsService/SetError"
+	MetricsService_GetGPUState_FullMethodName   = "/metricssvc.MetricsService/GetGPUState"
+	MetricsService_List_FullMethodName          = "/metricssvc.MetricsService/List"
+	MetricsService_SetError_FullMethodName      = "/metricssvc.MetricsService/SetError"
+	MetricsService_WatchGPUState_FullMethodName = "/metricssvc.MetricsService/WatchGPUState"
 )
 
 // MetricsServiceClient is the client API for MetricsService service.
@@ -55,6 +56,8 @@ type MetricsServiceClient interface {
 	GetGPUState(ctx context.Context, in *GPUGetRequest, opts ...grpc.CallOption) (*GPUStateResponse, error)
 	List(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GPUStateResponse, error)
 	SetError(ctx context.Context, in *GPUErrorRequest, opts ...grpc.CallOption) (*GPUErrorResponse, error)
+	// stream of the GPU state changes, all GPUs are watched when no ID is set
+	WatchGPUState(ctx context.Context, in *GPUGetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GPUStateWatchResponse], error)
 }
 
 type metricsServiceClient struct {
@@ -95,6 +98,25 @@ func (c *metricsServiceClient) SetError(ctx context.Context, in *GPUErrorRequest
 	return out, nil
 }
 
+func (c *metricsServiceClient) WatchGPUState(ctx context.Context, in *GPUGetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GPUStateWatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetricsService_ServiceDesc.Streams[0], MetricsService_WatchGPUState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GPUGetRequest, GPUStateWatchResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetricsService_WatchGPUStateClient = grpc.ServerStreamingClient[GPUStateWatchResponse]
+
 // MetricsServiceServer is the server API for MetricsService service.
 // All implementations must embed UnimplementedMetricsServiceServer
 // for forward compatibility.
@@ -103,6 +125,8 @@ type MetricsServiceServer interface {
 	GetGPUState(context.Context, *GPUGetRequest) (*GPUStateResponse, error)
 	List(context.Context, *empty.Empty) (*GPUStateResponse, error)
 	SetError(context.Context, *GPUErrorRequest) (*GPUErrorResponse, error)
+	// stream of the GPU state changes, all GPUs are watched when no ID is set
+	WatchGPUState(*GPUGetRequest, grpc.ServerStreamingServer[GPUStateWatchResponse]) error
 	mustEmbedUnimplementedMetricsServiceServer()
 }
 
@@ -122,6 +146,9 @@ func (UnimplementedMetricsServiceServer) List(context.Context, *empty.Empty) (*G
 func (UnimplementedMetricsServiceServer) SetError(context.Context, *GPUErrorRequest) (*GPUErrorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetError not implemented")
 }
+func (UnimplementedMetricsServiceServer) WatchGPUState(*GPUGetRequest, grpc.ServerStreamingServer[GPUStateWatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGPUState not implemented")
+}
 func (UnimplementedMetricsServiceServer) mustEmbedUnimplementedMetricsServiceServer() {}
 func (UnimplementedMetricsServiceServer) testEmbeddedByValue()                        {}
 
@@ -197,6 +224,17 @@ func _MetricsService_SetError_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetricsService_WatchGPUState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GPUGetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetricsServiceServer).WatchGPUState(m, &grpc.GenericServerStream[GPUGetRequest, GPUStateWatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetricsService_WatchGPUStateServer = grpc.ServerStreamingServer[GPUStateWatchResponse]
+
 // MetricsService_ServiceDesc is the grpc.ServiceDesc for MetricsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +255,12 @@ var MetricsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _MetricsService_SetError_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGPUState",
+			Handler:       _MetricsService_WatchGPUState_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "metricssvc.proto",
 }
@@ -32,6 +32,9 @@ const (
 
 	MetricsSocketPath = "/var/lib/amd-metrics-exporter/amdgpu_device_metrics_exporter_grpc.socket"
 
+	// remote write default write ahead log directory
+	RemoteWriteWALDir = "/var/lib/amd-metrics-exporter/remote-write-wal"
+
 	//PodResourceSocket - k8s pod grpc socket
 	PodResourceSocket = "/var/lib/kubelet/pod-resources/kubelet.sock"
 
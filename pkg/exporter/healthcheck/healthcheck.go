@@ -0,0 +1,138 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package healthcheck holds the dependency checks behind the /healthz and
+// /readyz probe endpoints
+package healthcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+)
+
+// Kind of a check, liveness checks fail /healthz and /readyz, readiness
+// checks only /readyz
+type Kind int
+
+const (
+	Readiness Kind = iota
+	Liveness
+)
+
+const (
+	statusOK     = "ok"
+	statusFailed = "failed"
+)
+
+// CheckFunc returns nil when the dependency is healthy
+type CheckFunc func() error
+
+type check struct {
+	name string
+	kind Kind
+	fn   CheckFunc
+}
+
+// Registry of the dependency checks
+type Registry struct {
+	sync.Mutex
+	checks []check
+}
+
+// CheckResult is the json detail of a single check
+type CheckResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Code   int    `json:"code"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Result is the json detail of a probe
+type Result struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a check, a check registered again with the same name
+// replaces the previous one
+func (r *Registry) Register(name string, kind Kind, fn CheckFunc) {
+	r.Lock()
+	defer r.Unlock()
+	for i := range r.checks {
+		if r.checks[i].name == name {
+			r.checks[i] = check{name: name, kind: kind, fn: fn}
+			return
+		}
+	}
+	r.checks = append(r.checks, check{name: name, kind: kind, fn: fn})
+}
+
+// Run runs the checks of the probe kind, or the named check only when name
+// is set. The second return value is false for an unknown check name.
+func (r *Registry) Run(kind Kind, name string) (*Result, bool) {
+	r.Lock()
+	checks := append([]check{}, r.checks...)
+	r.Unlock()
+
+	res := &Result{Status: statusOK, Checks: []CheckResult{}}
+	found := false
+	for _, c := range checks {
+		if name != "" && c.name != name {
+			continue
+		}
+		if name == "" && kind == Liveness && c.kind != Liveness {
+			continue
+		}
+		found = true
+		cr := CheckResult{Name: c.name, Status: statusOK, Code: http.StatusOK}
+		if err := c.fn(); err != nil {
+			cr.Status = statusFailed
+			cr.Code = http.StatusServiceUnavailable
+			cr.Error = err.Error()
+			res.Status = statusFailed
+		}
+		res.Checks = append(res.Checks, cr)
+	}
+	return res, name == "" || found
+}
+
+// Handler serves the checks of the probe kind, a single check is served
+// when the route has a check variable
+func (r *Registry) Handler(kind Kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		name := mux.Vars(req)["check"]
+		res, ok := r.Run(kind, name)
+		if !ok {
+			http.Error(w, fmt.Sprintf("check %v not found", name), http.StatusNotFound)
+			return
+		}
+		code := http.StatusOK
+		if res.Status != statusOK {
+			code = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
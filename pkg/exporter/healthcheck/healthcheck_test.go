@@ -0,0 +1,83 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package healthcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"gotest.tools/assert"
+)
+
+func probe(t *testing.T, router *mux.Router, path string) (int, *Result) {
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if rec.Code == http.StatusNotFound {
+		return rec.Code, nil
+	}
+	assert.Equal(t, rec.Header().Get("Content-Type"), "application/json")
+	res := &Result{}
+	assert.Assert(t, json.Unmarshal(rec.Body.Bytes(), res) == nil, "invalid json %v", rec.Body.String())
+	return rec.Code, res
+}
+
+func TestProbes(t *testing.T) {
+	r := NewRegistry()
+	var agentErr error
+	r.Register("socket", Liveness, func() error { return nil })
+	r.Register("gpuagent", Readiness, func() error { return agentErr })
+
+	router := mux.NewRouter()
+	router.HandleFunc("/healthz", r.Handler(Liveness))
+	router.HandleFunc("/readyz", r.Handler(Readiness))
+	router.HandleFunc("/readyz/{check}", r.Handler(Readiness))
+
+	code, res := probe(t, router, "/readyz")
+	assert.Equal(t, code, http.StatusOK)
+	assert.Equal(t, res.Status, statusOK)
+	assert.Equal(t, len(res.Checks), 2)
+
+	// readiness failures don't fail liveness
+	agentErr = fmt.Errorf("gpuagent connection TRANSIENT_FAILURE")
+	code, res = probe(t, router, "/readyz")
+	assert.Equal(t, code, http.StatusServiceUnavailable)
+	assert.Equal(t, res.Status, statusFailed)
+	assert.DeepEqual(t, res.Checks[1], CheckResult{Name: "gpuagent", Status: statusFailed,
+		Code: http.StatusServiceUnavailable, Error: agentErr.Error()})
+	code, res = probe(t, router, "/healthz")
+	assert.Equal(t, code, http.StatusOK)
+	assert.Equal(t, len(res.Checks), 1)
+
+	// single checks
+	code, _ = probe(t, router, "/readyz/socket")
+	assert.Equal(t, code, http.StatusOK)
+	code, res = probe(t, router, "/readyz/gpuagent")
+	assert.Equal(t, code, http.StatusServiceUnavailable)
+	assert.Equal(t, len(res.Checks), 1)
+	code, _ = probe(t, router, "/readyz/unknown")
+	assert.Equal(t, code, http.StatusNotFound)
+
+	// registering again replaces the check
+	r.Register("gpuagent", Readiness, func() error { return nil })
+	code, res = probe(t, router, "/readyz")
+	assert.Equal(t, code, http.StatusOK)
+	assert.Equal(t, len(res.Checks), 2)
+}
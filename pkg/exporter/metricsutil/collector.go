@@ -0,0 +1,120 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// DefaultCollectionInterval is used when the config doesn't specify one
+	DefaultCollectionInterval = 15 * time.Second
+)
+
+// metricsSnapshot is an immutable copy of the registry taken by the
+// collector, scrapes only ever read from it
+type metricsSnapshot struct {
+	families  []*dto.MetricFamily
+	timestamp time.Time
+}
+
+// GetCollectionInterval : returns the background collection interval
+func (mh *MetricsHandler) GetCollectionInterval() time.Duration {
+	config := mh.runConf.GetConfig()
+	if config == nil || config.GetCommonConfig() == nil {
+		return DefaultCollectionInterval
+	}
+	interval := config.GetCommonConfig().GetMetricsCollectionInterval()
+	if interval == 0 {
+		return DefaultCollectionInterval
+	}
+	return time.Duration(interval) * time.Second
+}
+
+// CollectMetrics : pulls the latest stats from all the clients and replaces
+// the snapshot served to scrapes
+func (mh *MetricsHandler) CollectMetrics() error {
+	mh.Lock()
+	defer mh.Unlock()
+	defer selfmetrics.ObserveCollection(time.Now())
+	_ = mh.UpdateMetrics()
+	families, err := mh.reg.Gather()
+	if err != nil {
+		// gather is best effort, keep what was collected
+		logger.Log.Printf("metrics gather err: %v", err)
+	}
+	now := time.Now()
+	mh.snapshot.Store(&metricsSnapshot{
+		families:  families,
+		timestamp: now,
+	})
+	selfmetrics.SetLastCollection(now)
+	return err
+}
+
+// StartCollector : collects metrics every collection interval until the
+// context is cancelled, interval changes are picked up on the next cycle
+func (mh *MetricsHandler) StartCollector(ctx context.Context) {
+	logger.Log.Printf("starting metrics collector, interval %v", mh.GetCollectionInterval())
+	for {
+		_ = mh.CollectMetrics()
+		select {
+		case <-ctx.Done():
+			logger.Log.Printf("metrics collector stopped")
+			return
+		case <-mh.collectNow:
+		case <-time.After(mh.GetCollectionInterval()):
+		}
+	}
+}
+
+// triggerCollection : request an out of cycle collection, no-op if one is
+// already pending
+func (mh *MetricsHandler) triggerCollection() {
+	select {
+	case mh.collectNow <- struct{}{}:
+	default:
+	}
+}
+
+// GetSnapshotTime : returns the time of the last completed collection, zero
+// if nothing has been collected yet
+func (mh *MetricsHandler) GetSnapshotTime() time.Time {
+	if s := mh.snapshot.Load(); s != nil {
+		return s.timestamp
+	}
+	return time.Time{}
+}
+
+// GetGatherer : returns a gatherer serving the last collected snapshot, a
+// collection is done inline only when no snapshot exists yet
+func (mh *MetricsHandler) GetGatherer() prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		s := mh.snapshot.Load()
+		if s == nil {
+			_ = mh.CollectMetrics()
+			s = mh.snapshot.Load()
+		}
+		return s.families, nil
+	})
+}
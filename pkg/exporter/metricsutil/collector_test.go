@@ -0,0 +1,118 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsutil
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"gotest.tools/assert"
+)
+
+// counterClient reports the number of updates it has seen as a gauge
+type counterClient struct {
+	mh      *MetricsHandler
+	updates atomic.Int64
+	gauge   prometheus.Gauge
+}
+
+func (c *counterClient) UpdateStaticMetrics() error { return nil }
+func (c *counterClient) GetExportLabels() []string  { return nil }
+func (c *counterClient) ResetMetrics() error        { return nil }
+
+func (c *counterClient) InitConfigs() error {
+	c.gauge = prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_updates"})
+	return c.mh.RegisterMetric(c.gauge)
+}
+
+func (c *counterClient) UpdateMetricsStats() error {
+	c.gauge.Set(float64(c.updates.Add(1)))
+	return nil
+}
+
+func gatherValue(t *testing.T, name string) float64 {
+	families, err := mh.GetGatherer().Gather()
+	assert.Assert(t, err == nil, "gather failed %v", err)
+	for _, mf := range families {
+		if mf.GetName() == name {
+			return mf.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	t.Fatalf("metric %v not found", name)
+	return 0
+}
+
+func TestCollector(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	assert.Equal(t, mh.GetCollectionInterval(), DefaultCollectionInterval)
+	UpdateConfFile(t, &exportermetrics.MetricConfig{
+		CommonConfig: &exportermetrics.CommonConfig{
+			MetricsFieldPrefix:        "amd",
+			MetricsCollectionInterval: 1,
+		},
+	})
+	assert.Equal(t, mh.GetCollectionInterval(), time.Second)
+
+	client := &counterClient{mh: mh}
+	mh.RegisterMetricsClient(client)
+	mh.InitConfig()
+
+	// first scrape collects inline as there is no snapshot yet
+	assert.Equal(t, gatherValue(t, "amdtest_updates"), float64(1))
+	ts := mh.GetSnapshotTime()
+	assert.Assert(t, !ts.IsZero())
+	// the collection time is an exporter metric, not part of the snapshot
+	families, err := selfmetrics.Gatherer().Gather()
+	assert.Assert(t, err == nil)
+	lastCollection := 0.0
+	for _, mf := range families {
+		if mf.GetName() == "exporter_last_collection_timestamp_seconds" {
+			lastCollection = mf.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	assert.Equal(t, lastCollection, float64(ts.UnixNano())/1e9)
+
+	// scrapes are served from the snapshot without reaching the client
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, gatherValue(t, "amdtest_updates"), float64(1))
+	}
+	assert.Equal(t, client.updates.Load(), int64(1))
+
+	// a reload keeps serving the previous snapshot until the collector
+	// picks up the collection it triggers
+	mh.InitConfig()
+	assert.Equal(t, gatherValue(t, "amdtest_updates"), float64(1))
+	assert.Equal(t, client.updates.Load(), int64(1))
+
+	// collector refreshes the snapshot in the background
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go mh.StartCollector(ctx)
+	deadline := time.Now().Add(5 * time.Second)
+	for client.updates.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Assert(t, client.updates.Load() >= 3, "collector didn't run, updates %v", client.updates.Load())
+	assert.Assert(t, mh.GetSnapshotTime().After(ts))
+}
@@ -0,0 +1,195 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/parserutil"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/relabel"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+)
+
+// gpuIDLabel identifies the GPU of a series, always kept by label filters
+var gpuIDLabel = strings.ToLower(exportermetrics.GPUMetricLabel_GPU_ID.String())
+
+// MetricsFilter selects a subset of the gathered metrics, a nil set
+// selects everything
+type MetricsFilter struct {
+	metrics map[string]bool // prefixed metric names
+	gpus    map[string]bool // gpu_id label values
+	labels  map[string]bool
+}
+
+// NewMetricsFilter : builds a filter from GPUMetricField names, a GPU index
+// selector (e.g. 0-3,6) and label names
+func (mh *MetricsHandler) NewMetricsFilter(fields []string, selector string, labels []string) (*MetricsFilter, error) {
+	f := &MetricsFilter{}
+	if len(fields) != 0 {
+		f.metrics = make(map[string]bool)
+		prefix := mh.GetPrefix()
+		for _, field := range fields {
+			field = strings.ToUpper(strings.TrimSpace(field))
+			if _, ok := exportermetrics.GPUMetricField_value[field]; !ok {
+				return nil, fmt.Errorf("invalid field %v", field)
+			}
+			for _, client := range mh.clients {
+				resolver, ok := client.(FieldMetricsResolver)
+				if !ok {
+					continue
+				}
+				for _, name := range resolver.GetFieldMetricNames(field) {
+					f.metrics[prefix+name] = true
+				}
+			}
+		}
+	}
+	if selector != "" {
+		indices, err := parserutil.RangeStrToIntIndices(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gpu selector %v, %v", selector, err)
+		}
+		f.gpus = make(map[string]bool)
+		for _, idx := range indices {
+			f.gpus[strconv.Itoa(idx)] = true
+		}
+	}
+	if len(labels) != 0 {
+		f.labels = map[string]bool{gpuIDLabel: true}
+		for _, label := range labels {
+			f.labels[strings.ToLower(strings.TrimSpace(label))] = true
+		}
+	}
+	return f, nil
+}
+
+// NewProfileFilter : builds a filter from a scrape profile
+func (mh *MetricsHandler) NewProfileFilter(profile *exportermetrics.ScrapeProfile) (*MetricsFilter, error) {
+	return mh.NewMetricsFilter(profile.GetFields(), profile.GetSelector(), profile.GetLabels())
+}
+
+// selectSeries returns the series with only the selected labels, nil if
+// the series is filtered out
+func (f *MetricsFilter) selectSeries(m *dto.Metric) *dto.Metric {
+	if f.gpus != nil {
+		for _, l := range m.GetLabel() {
+			// series without a gpu (e.g. node totals) are kept
+			if l.GetName() == gpuIDLabel && !f.gpus[l.GetValue()] {
+				return nil
+			}
+		}
+	}
+	if f.labels == nil {
+		return m
+	}
+	labels := make([]*dto.LabelPair, 0, len(m.GetLabel()))
+	for _, l := range m.GetLabel() {
+		if f.labels[l.GetName()] {
+			labels = append(labels, l)
+		}
+	}
+	if len(labels) == len(m.GetLabel()) {
+		return m
+	}
+	// the snapshot is shared, never modify its series
+	selected := proto.Clone(m).(*dto.Metric)
+	selected.Label = labels
+	return selected
+}
+
+// Apply : returns the selected families, the input is left as is
+func (f *MetricsFilter) Apply(families []*dto.MetricFamily) []*dto.MetricFamily {
+	selected := make([]*dto.MetricFamily, 0, len(families))
+	for _, mf := range families {
+		if f.metrics != nil && !f.metrics[mf.GetName()] {
+			continue
+		}
+		metrics := make([]*dto.Metric, 0, len(mf.GetMetric()))
+		seen := make(map[string]bool)
+		for _, m := range mf.GetMetric() {
+			s := f.selectSeries(m)
+			if s == nil {
+				continue
+			}
+			if f.labels != nil {
+				// dropped labels can make series identical, keep the first
+				key := seriesLabelKey(s)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+			metrics = append(metrics, s)
+		}
+		if len(metrics) == 0 {
+			continue
+		}
+		selected = append(selected, &dto.MetricFamily{
+			Name:   mf.Name,
+			Help:   mf.Help,
+			Type:   mf.Type,
+			Unit:   mf.Unit,
+			Metric: metrics,
+		})
+	}
+	return selected
+}
+
+func seriesLabelKey(m *dto.Metric) string {
+	var sb strings.Builder
+	for _, l := range m.GetLabel() {
+		sb.WriteString(l.GetName())
+		sb.WriteByte(0)
+		sb.WriteString(l.GetValue())
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// FilterGatherer : returns a gatherer applying the filters in order to the
+// families of g
+func FilterGatherer(g prometheus.Gatherer, filters ...*MetricsFilter) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := g.Gather()
+		for _, f := range filters {
+			families = f.Apply(families)
+		}
+		return families, err
+	})
+}
+
+// GetRelabelRules : returns the relabel rules of the running config
+func (mh *MetricsHandler) GetRelabelRules() relabel.Rules {
+	if rules := mh.relabel.Load(); rules != nil {
+		return *rules
+	}
+	return nil
+}
+
+// RelabelGatherer : returns a gatherer applying the relabel rules of the
+// running config to the families of g
+func (mh *MetricsHandler) RelabelGatherer(g prometheus.Gatherer) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := g.Gather()
+		return mh.GetRelabelRules().Apply(families), err
+	})
+}
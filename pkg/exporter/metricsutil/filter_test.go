@@ -0,0 +1,132 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"gotest.tools/assert"
+)
+
+// fieldClient exports power and temperature for 4 GPUs
+type fieldClient struct {
+	mh    *MetricsHandler
+	power *prometheus.GaugeVec
+	temp  *prometheus.GaugeVec
+}
+
+func (c *fieldClient) UpdateStaticMetrics() error { return nil }
+func (c *fieldClient) GetExportLabels() []string  { return nil }
+func (c *fieldClient) ResetMetrics() error        { return nil }
+
+func (c *fieldClient) InitConfigs() error {
+	labels := []string{"gpu_id", "pod", "serial_number"}
+	c.power = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "gpu_package_power"}, labels)
+	c.temp = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "gpu_edge_temperature"}, labels)
+	if err := c.mh.RegisterMetric(c.power); err != nil {
+		return err
+	}
+	return c.mh.RegisterMetric(c.temp)
+}
+
+func (c *fieldClient) UpdateMetricsStats() error {
+	for i := 0; i < 4; i++ {
+		c.power.WithLabelValues(fmt.Sprint(i), "pod", fmt.Sprint("sn", i)).Set(float64(100 + i))
+		c.temp.WithLabelValues(fmt.Sprint(i), "pod", fmt.Sprint("sn", i)).Set(float64(40 + i))
+	}
+	return nil
+}
+
+func (c *fieldClient) GetFieldMetricNames(field string) []string {
+	switch field {
+	case exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String():
+		return []string{"gpu_package_power"}
+	case exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE.String():
+		return []string{"gpu_edge_temperature"}
+	}
+	return nil
+}
+
+func gatherFiltered(t *testing.T, filters ...*MetricsFilter) map[string][]*dto.Metric {
+	families, err := FilterGatherer(mh.GetGatherer(), filters...).Gather()
+	assert.Assert(t, err == nil, "gather failed %v", err)
+	result := map[string][]*dto.Metric{}
+	for _, mf := range families {
+		result[mf.GetName()] = mf.GetMetric()
+	}
+	return result
+}
+
+func TestMetricsFilter(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	mh.RegisterMetricsClient(&fieldClient{mh: mh})
+	mh.InitConfig()
+
+	// no filter
+	all := gatherFiltered(t)
+	assert.Equal(t, len(all["amdgpu_package_power"]), 4)
+	assert.Equal(t, len(all["amdgpu_edge_temperature"]), 4)
+
+	// fields and gpus
+	f, err := mh.NewMetricsFilter([]string{"gpu_package_power"}, "1-2", nil)
+	assert.Assert(t, err == nil, "filter create failed %v", err)
+	filtered := gatherFiltered(t, f)
+	assert.Equal(t, len(filtered), 1)
+	power := filtered["amdgpu_package_power"]
+	assert.Equal(t, len(power), 2)
+	assert.Equal(t, power[0].GetGauge().GetValue(), float64(101))
+	assert.Equal(t, power[1].GetGauge().GetValue(), float64(102))
+	assert.Equal(t, len(power[0].GetLabel()), 3)
+
+	// labels, gpu_id is always kept
+	f, err = mh.NewMetricsFilter(nil, "", []string{"pod"})
+	assert.Assert(t, err == nil)
+	filtered = gatherFiltered(t, f)
+	assert.Equal(t, len(filtered["amdgpu_edge_temperature"]), 4)
+	for _, m := range filtered["amdgpu_edge_temperature"] {
+		assert.Equal(t, len(m.GetLabel()), 2)
+		assert.Equal(t, m.GetLabel()[0].GetName(), "gpu_id")
+		assert.Equal(t, m.GetLabel()[1].GetName(), "pod")
+	}
+	// the snapshot isn't modified
+	assert.Equal(t, len(gatherFiltered(t)["amdgpu_edge_temperature"][0].GetLabel()), 3)
+
+	// profile narrowed down by query filters
+	profile, err := mh.NewProfileFilter(&exportermetrics.ScrapeProfile{
+		Fields:   []string{"GPU_PACKAGE_POWER", "GPU_EDGE_TEMPERATURE"},
+		Selector: "0,1",
+	})
+	assert.Assert(t, err == nil)
+	f, err = mh.NewMetricsFilter([]string{"GPU_EDGE_TEMPERATURE"}, "1-3", nil)
+	assert.Assert(t, err == nil)
+	filtered = gatherFiltered(t, profile, f)
+	assert.Equal(t, len(filtered), 1)
+	assert.Equal(t, len(filtered["amdgpu_edge_temperature"]), 1)
+	assert.Equal(t, filtered["amdgpu_edge_temperature"][0].GetGauge().GetValue(), float64(41))
+
+	// invalid filters
+	_, err = mh.NewMetricsFilter([]string{"GPU_UNKNOWN"}, "", nil)
+	assert.Assert(t, err != nil, "expecting invalid field error")
+	_, err = mh.NewMetricsFilter(nil, "3-1", nil)
+	assert.Assert(t, err != nil, "expecting invalid selector error")
+}
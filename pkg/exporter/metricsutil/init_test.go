@@ -82,6 +82,11 @@ func setupTest(t *testing.T) func(t *testing.T) {
 }
 
 func UpdateConfFile(t *testing.T, newConf *exportermetrics.MetricConfig) {
+	assert.Assert(t, writeConfFile(t, newConf) == nil, "config update failed")
+}
+
+// writeConfFile writes the config file and returns the reload result
+func writeConfFile(t *testing.T, newConf *exportermetrics.MetricConfig) error {
 	jsonData, err := json.MarshalIndent(newConf, "", "  ")
 	if err != nil {
 		t.Fatalf("Failed to marshal JSON: %s", err)
@@ -91,5 +96,5 @@ func UpdateConfFile(t *testing.T, newConf *exportermetrics.MetricConfig) {
 	if err != nil {
 		t.Fatalf("Failed to write JSON to file: %s", err)
 	}
-	assert.Assert(t, chandler.RefreshConfig() == nil, "config update failed")
+	return chandler.RefreshConfig()
 }
@@ -18,23 +18,39 @@ package metricsutil
 
 import (
 	"regexp"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/config"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/relabel"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// metric type modes for cumulative fields
+const (
+	MetricTypeGauge   = "gauge"
+	MetricTypeCounter = "counter"
+	MetricTypeBoth    = "both"
+)
+
 type MetricsHandler struct {
-	reg     *prometheus.Registry
-	runConf *config.ConfigHandler
-	clients []MetricsInterface
+	// serializes config init and collection on the registry
+	sync.Mutex
+	reg        *prometheus.Registry
+	runConf    *config.ConfigHandler
+	clients    []MetricsInterface
+	snapshot   atomic.Pointer[metricsSnapshot]
+	relabel    atomic.Pointer[relabel.Rules]
+	collectNow chan struct{}
 }
 
 func NewMetrics(c *config.ConfigHandler) (*MetricsHandler, error) {
 	metricsHandler := MetricsHandler{
-		runConf: c,
+		runConf:    c,
+		collectNow: make(chan struct{}, 1),
 	}
 	metricsHandler.clients = []MetricsInterface{}
 	return &metricsHandler, nil
@@ -63,11 +79,24 @@ func (mh *MetricsHandler) RegisterMetricsClient(client MetricsInterface) {
 	mh.clients = append(mh.clients, client)
 }
 
-func (mh *MetricsHandler) InitConfig() {
+// InitConfig : builds a new registry for the current config, scrapes are
+// served from the previous snapshot until the collection it triggers
+// replaces it. Config read errors are returned, the running config is kept.
+func (mh *MetricsHandler) InitConfig() error {
+	mh.Lock()
+	defer mh.Unlock()
+	defer mh.triggerCollection()
 	mh.reg = prometheus.NewRegistry()
-	if err := mh.runConf.RefreshConfig(); err != nil {
-		logger.Log.Printf("failed to refresh config: %v", err)
+	refreshErr := mh.runConf.RefreshConfig()
+	if refreshErr != nil {
+		logger.Log.Printf("failed to refresh config: %v", refreshErr)
+	}
+	rules, err := relabel.Compile(mh.runConf.GetConfig().GetCommonConfig().GetRelabelConfigs())
+	if err != nil {
+		// rejected by the config validation, not expected
+		logger.Log.Printf("relabel config err: %v, series exported as is", err)
 	}
+	mh.relabel.Store(&rules)
 	var wg sync.WaitGroup
 	for _, client := range mh.clients {
 		wg.Add(1)
@@ -82,9 +111,11 @@ func (mh *MetricsHandler) InitConfig() {
 		}(client)
 	}
 	wg.Wait()
+	return refreshErr
 }
 
-// UpdateMetrics : send on demand update metrics request
+// UpdateMetrics : send on demand update metrics request, scrapes are served
+// from the collector snapshot and shouldn't call this directly
 func (mh *MetricsHandler) UpdateMetrics() error {
 	var wg sync.WaitGroup
 	for _, client := range mh.clients {
@@ -145,3 +176,21 @@ func (mh *MetricsHandler) GetPrefix() string {
 	logger.Log.Printf("defaulting to no prefix behavior")
 	return ""
 }
+
+// GetMetricTypeMode : returns the export type of cumulative fields, gauge
+// unless counter or both is configured
+func (mh *MetricsHandler) GetMetricTypeMode() string {
+	config := mh.runConf.GetConfig()
+	if config == nil || config.GetCommonConfig() == nil {
+		return MetricTypeGauge
+	}
+	mode := strings.ToLower(config.GetCommonConfig().GetMetricTypeMode())
+	switch mode {
+	case "", MetricTypeGauge:
+		return MetricTypeGauge
+	case MetricTypeCounter, MetricTypeBoth:
+		return mode
+	}
+	logger.Log.Printf("invalid metric type mode configured %v, defaulting to %v", mode, MetricTypeGauge)
+	return MetricTypeGauge
+}
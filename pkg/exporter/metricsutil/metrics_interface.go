@@ -37,3 +37,10 @@ type MetricsClient interface {
 	// client registration to the metric handler
 	RegisterMetricsClient(MetricsInterface) error
 }
+
+// FieldMetricsResolver is optionally implemented by clients exporting
+// GPUMetricField values, used to filter scrapes by field
+type FieldMetricsResolver interface {
+	// metric names exported for the field, without the prefix
+	GetFieldMetricNames(field string) []string
+}
@@ -32,7 +32,8 @@ func TestMetricsHandler(t *testing.T) {
 	prefix := mh.GetPrefix()
 	assert.Equal(t, prefix, "amd", fmt.Sprintf("expected configured prefix amd but got %v", prefix))
 
-	// udpate prefix to invalid prefix
+	// udpate prefix to invalid prefix, the config is rejected and the
+	// running prefix kept
 	invalidPrefixList := []string{"amd-", "-amd"}
 	for _, ipre := range invalidPrefixList {
 		invalidPrefixConfig := &exportermetrics.MetricConfig{
@@ -40,9 +41,9 @@ func TestMetricsHandler(t *testing.T) {
 				MetricsFieldPrefix: ipre,
 			},
 		}
-		UpdateConfFile(t, invalidPrefixConfig)
+		assert.Assert(t, writeConfFile(t, invalidPrefixConfig) != nil, "expected invalid prefix %v rejected", ipre)
 		newPref := mh.GetPrefix()
-		assert.Equal(t, newPref, "", fmt.Sprintf("expected empty prefix but got %v", newPref))
+		assert.Equal(t, newPref, "amd", fmt.Sprintf("expected running prefix amd but got %v", newPref))
 	}
 
 	// Test with valid prefixes
@@ -76,7 +77,7 @@ func TestMetricsHandler(t *testing.T) {
 				MetricsFieldPrefix: ipre,
 			},
 		}
-		UpdateConfFile(t, invalidPrefixConfig)
+		assert.Assert(t, writeConfFile(t, invalidPrefixConfig) != nil, "expected invalid prefix %v rejected", ipre)
 		newPref := mh.GetPrefix()
 		assert.Equal(t, newPref, "", fmt.Sprintf("expected empty prefix but got %v", newPref))
 	}
@@ -0,0 +1,398 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+
+	serviceName    = "amd-device-metrics-exporter"
+	scopeName      = "github.com/ROCm/device-metrics-exporter"
+	httpMetricPath = "/v1/metrics"
+	exportTimeout  = 10 * time.Second
+
+	// the hostname label is the host.name resource attribute
+	hostnameLabel    = "hostname"
+	hostNameResource = "host.name"
+)
+
+// exporter sends an export request to the collector
+type exporter interface {
+	export(ctx context.Context, req *collectorpb.ExportMetricsServiceRequest) error
+	close() error
+}
+
+// Pusher periodically pushes the gathered metrics to an OTLP collector
+type Pusher struct {
+	gatherer  prometheus.Gatherer
+	exp       exporter
+	interval  time.Duration
+	startTime time.Time
+	// node scoped labels, moved from the datapoints to the resource
+	nodeLabels    map[string]bool
+	resourceAttrs map[string]string
+	extraAttrs    map[string]string
+}
+
+// NewPusher creates a pusher for the config, defaultInterval is used when
+// the config doesn't set a push interval. nodeLabels are the labels of the
+// node rather than the device, e.g. hostname and the custom labels, they
+// are pushed as resource attributes. startTime is the start of the counters
+// without a created timestamp, the process start rather than the pusher's
+func NewPusher(conf *exportermetrics.OTLPConfig, gatherer prometheus.Gatherer, defaultInterval time.Duration,
+	nodeLabels []string, startTime time.Time) (*Pusher, error) {
+	if conf.GetEndpoint() == "" {
+		return nil, fmt.Errorf("OTLP configured without Endpoint")
+	}
+	tlsConf, err := clientTLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	var exp exporter
+	switch strings.ToLower(conf.GetProtocol()) {
+	case "", ProtocolGRPC:
+		exp, err = newGRPCExporter(conf, tlsConf)
+	case ProtocolHTTP:
+		exp, err = newHTTPExporter(conf, tlsConf)
+	default:
+		return nil, fmt.Errorf("invalid OTLP protocol %q, expecting %v or %v", conf.GetProtocol(), ProtocolGRPC, ProtocolHTTP)
+	}
+	if err != nil {
+		return nil, err
+	}
+	interval := defaultInterval
+	if conf.GetPushInterval() != 0 {
+		interval = time.Duration(conf.GetPushInterval()) * time.Second
+	}
+	p := &Pusher{
+		gatherer:      gatherer,
+		exp:           exp,
+		interval:      interval,
+		startTime:     startTime,
+		nodeLabels:    map[string]bool{hostnameLabel: true},
+		resourceAttrs: map[string]string{"service.name": serviceName},
+		extraAttrs:    conf.GetResourceAttributes(),
+	}
+	for _, l := range nodeLabels {
+		p.nodeLabels[l] = true
+	}
+	if hostname, err := utils.GetHostName(); err == nil {
+		p.resourceAttrs[hostNameResource] = hostname
+	}
+	return p, nil
+}
+
+// Run pushes every push interval until the context is cancelled
+func (p *Pusher) Run(ctx context.Context) {
+	logger.Log.Printf("starting OTLP push, interval %v", p.interval)
+	defer p.exp.close()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Log.Printf("OTLP push stopped")
+			return
+		case <-ticker.C:
+			if err := p.Push(ctx); err != nil {
+				logger.Log.Printf("OTLP push err: %v", err)
+			}
+		}
+	}
+}
+
+// Push gathers the metrics and sends them to the collector once
+func (p *Pusher) Push(ctx context.Context) error {
+	families, err := p.gatherer.Gather()
+	if err != nil {
+		return fmt.Errorf("gather failed, %v", err)
+	}
+	req := p.convert(families, time.Now())
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+	return p.exp.export(ctx, req)
+}
+
+// newResource returns the resource with the node label values found in the
+// series, the configured resource attributes take precedence
+func (p *Pusher) newResource(nodeAttrs map[string]string) *resourcepb.Resource {
+	attrs := make(map[string]string, len(p.resourceAttrs)+len(nodeAttrs)+len(p.extraAttrs))
+	for k, v := range p.resourceAttrs {
+		attrs[k] = v
+	}
+	for k, v := range nodeAttrs {
+		if k == hostnameLabel {
+			k = hostNameResource
+		}
+		attrs[k] = v
+	}
+	for k, v := range p.extraAttrs {
+		attrs[k] = v
+	}
+	return &resourcepb.Resource{Attributes: toAttributes(attrs)}
+}
+
+func toAttributes(m map[string]string) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]*commonpb.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, &commonpb.KeyValue{
+			Key:   k,
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: m[k]}},
+		})
+	}
+	return attrs
+}
+
+// datapointAttributes returns the series labels as attributes, node scoped
+// labels are collected in nodeAttrs for the resource instead
+func (p *Pusher) datapointAttributes(labels []*dto.LabelPair, nodeAttrs map[string]string) []*commonpb.KeyValue {
+	m := make(map[string]string, len(labels))
+	for _, l := range labels {
+		if p.nodeLabels[l.GetName()] {
+			if _, ok := nodeAttrs[l.GetName()]; !ok {
+				nodeAttrs[l.GetName()] = l.GetValue()
+			}
+			continue
+		}
+		m[l.GetName()] = l.GetValue()
+	}
+	return toAttributes(m)
+}
+
+// convert maps the gathered families to an export request, gauges and
+// untyped metrics are gauges and counters are cumulative monotonic sums
+func (p *Pusher) convert(families []*dto.MetricFamily, now time.Time) *collectorpb.ExportMetricsServiceRequest {
+	ts := uint64(now.UnixNano())
+	nodeAttrs := map[string]string{}
+	metrics := make([]*metricspb.Metric, 0, len(families))
+	for _, mf := range families {
+		var points []*metricspb.NumberDataPoint
+		for _, m := range mf.GetMetric() {
+			dp := &metricspb.NumberDataPoint{
+				Attributes:   p.datapointAttributes(m.GetLabel(), nodeAttrs),
+				TimeUnixNano: ts,
+			}
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				dp.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: m.GetCounter().GetValue()}
+				start := p.startTime
+				if ct := m.GetCounter().GetCreatedTimestamp(); ct != nil {
+					start = ct.AsTime()
+				}
+				dp.StartTimeUnixNano = uint64(start.UnixNano())
+			case dto.MetricType_GAUGE:
+				dp.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: m.GetGauge().GetValue()}
+			case dto.MetricType_UNTYPED:
+				dp.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: m.GetUntyped().GetValue()}
+			default:
+				// summaries and histograms are not exported by the device
+				// collectors
+				continue
+			}
+			points = append(points, dp)
+		}
+		if len(points) == 0 {
+			continue
+		}
+		metric := &metricspb.Metric{
+			Name:        mf.GetName(),
+			Description: mf.GetHelp(),
+		}
+		if mf.GetType() == dto.MetricType_COUNTER {
+			metric.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				DataPoints:             points,
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			}}
+		} else {
+			metric.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: points}}
+		}
+		metrics = append(metrics, metric)
+	}
+	return &collectorpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: p.newResource(nodeAttrs),
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{
+						Scope:   &commonpb.InstrumentationScope{Name: scopeName},
+						Metrics: metrics,
+					},
+				},
+			},
+		},
+	}
+}
+
+func clientTLSConfig(conf *exportermetrics.OTLPConfig) (*tls.Config, error) {
+	if conf.GetInsecure() {
+		return nil, nil
+	}
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile := conf.GetCAFile(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OTLP CA %v, %v", caFile, err)
+		}
+		tlsConf.RootCAs = x509.NewCertPool()
+		if !tlsConf.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates found in OTLP CA %v", caFile)
+		}
+	}
+	return tlsConf, nil
+}
+
+type grpcExporter struct {
+	conn    *grpc.ClientConn
+	client  collectorpb.MetricsServiceClient
+	headers metadata.MD
+}
+
+func newGRPCExporter(conf *exportermetrics.OTLPConfig, tlsConf *tls.Config) (*grpcExporter, error) {
+	creds := insecure.NewCredentials()
+	if tlsConf != nil {
+		creds = credentials.NewTLS(tlsConf)
+	}
+	conn, err := grpc.NewClient(conf.GetEndpoint(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("OTLP grpc client create failed, %v", err)
+	}
+	return &grpcExporter{
+		conn:    conn,
+		client:  collectorpb.NewMetricsServiceClient(conn),
+		headers: metadata.New(conf.GetHeaders()),
+	}, nil
+}
+
+func (g *grpcExporter) export(ctx context.Context, req *collectorpb.ExportMetricsServiceRequest) error {
+	ctx = metadata.NewOutgoingContext(ctx, g.headers)
+	resp, err := g.client.Export(ctx, req)
+	if err != nil {
+		return fmt.Errorf("OTLP grpc export failed, %v", err)
+	}
+	if ps := resp.GetPartialSuccess(); ps.GetRejectedDataPoints() != 0 {
+		logger.Log.Printf("OTLP collector rejected %v data points, %v", ps.GetRejectedDataPoints(), ps.GetErrorMessage())
+	}
+	return nil
+}
+
+func (g *grpcExporter) close() error {
+	return g.conn.Close()
+}
+
+type httpExporter struct {
+	url     string
+	client  *http.Client
+	headers map[string]string
+}
+
+func newHTTPExporter(conf *exportermetrics.OTLPConfig, tlsConf *tls.Config) (*httpExporter, error) {
+	endpoint := conf.GetEndpoint()
+	if !strings.Contains(endpoint, "://") {
+		scheme := "https"
+		if conf.GetInsecure() {
+			scheme = "http"
+		}
+		endpoint = scheme + "://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint %v, %v", conf.GetEndpoint(), err)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = httpMetricPath
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConf
+	return &httpExporter{
+		url:     u.String(),
+		client:  &http.Client{Transport: transport},
+		headers: conf.GetHeaders(),
+	}, nil
+}
+
+func (h *httpExporter) export(ctx context.Context, req *collectorpb.ExportMetricsServiceRequest) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("OTLP request marshal failed, %v", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range h.headers {
+		httpReq.Header.Set(k, v)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := h.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("OTLP http export failed, %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("OTLP http export failed, status %v", resp.Status)
+	}
+	var exportResp collectorpb.ExportMetricsServiceResponse
+	if err := proto.Unmarshal(respBody, &exportResp); err == nil {
+		if ps := exportResp.GetPartialSuccess(); ps.GetRejectedDataPoints() != 0 {
+			logger.Log.Printf("OTLP collector rejected %v data points, %v", ps.GetRejectedDataPoints(), ps.GetErrorMessage())
+		}
+	}
+	return nil
+}
+
+func (h *httpExporter) close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,207 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package otlp
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// receiver is an in-process OTLP collector recording the requests
+type receiver struct {
+	collectorpb.UnimplementedMetricsServiceServer
+	sync.Mutex
+	requests []*collectorpb.ExportMetricsServiceRequest
+	headers  []string
+}
+
+func (r *receiver) Export(ctx context.Context, req *collectorpb.ExportMetricsServiceRequest) (*collectorpb.ExportMetricsServiceResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+	r.requests = append(r.requests, req)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		r.headers = append(r.headers, md.Get("x-tenant")...)
+	}
+	return &collectorpb.ExportMetricsServiceResponse{}, nil
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	exportReq := &collectorpb.ExportMetricsServiceRequest{}
+	if req.URL.Path != httpMetricPath || req.Header.Get("Content-Type") != "application/x-protobuf" ||
+		proto.Unmarshal(body, exportReq) != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.Lock()
+	r.requests = append(r.requests, exportReq)
+	r.headers = append(r.headers, req.Header.Get("X-Tenant"))
+	r.Unlock()
+	resp, _ := proto.Marshal(&collectorpb.ExportMetricsServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(resp)
+}
+
+func (r *receiver) last() *collectorpb.ExportMetricsServiceRequest {
+	r.Lock()
+	defer r.Unlock()
+	if len(r.requests) == 0 {
+		return nil
+	}
+	return r.requests[len(r.requests)-1]
+}
+
+func newTestRegistry(t *testing.T) *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	power := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "gpu_power_usage", Help: "power"},
+		[]string{"gpu_id", "hostname", "rack"})
+	// a counter func has no created timestamp, as the const counters of the agent
+	energy := prometheus.NewCounterFunc(prometheus.CounterOpts{Name: "gpu_energy_consumed_total", Help: "energy",
+		ConstLabels: prometheus.Labels{"gpu_id": "0", "hostname": "node1", "rack": "r1"}},
+		func() float64 { return 1000 })
+	assert.Assert(t, reg.Register(power) == nil)
+	assert.Assert(t, reg.Register(energy) == nil)
+	power.WithLabelValues("0", "node1", "r1").Set(250)
+	power.WithLabelValues("1", "node1", "r1").Set(300)
+	return reg
+}
+
+func findMetric(req *collectorpb.ExportMetricsServiceRequest, name string) *metricspb.Metric {
+	for _, rm := range req.GetResourceMetrics() {
+		for _, sm := range rm.GetScopeMetrics() {
+			for _, m := range sm.GetMetrics() {
+				if m.GetName() == name {
+					return m
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// testStartTime is the process start passed to the pushers
+var testStartTime = time.Now().Add(-time.Hour)
+
+func verifyRequest(t *testing.T, req *collectorpb.ExportMetricsServiceRequest) {
+	assert.Assert(t, req != nil, "no request received")
+	resAttrs := map[string]string{}
+	for _, kv := range req.GetResourceMetrics()[0].GetResource().GetAttributes() {
+		resAttrs[kv.GetKey()] = kv.GetValue().GetStringValue()
+	}
+	assert.Equal(t, resAttrs["service.name"], serviceName)
+	assert.Equal(t, resAttrs["k8s.cluster.name"], "test")
+	assert.Equal(t, resAttrs["host.name"], "node1")
+	assert.Equal(t, resAttrs["rack"], "r1")
+
+	power := findMetric(req, "gpu_power_usage")
+	assert.Assert(t, power != nil)
+	assert.Equal(t, len(power.GetGauge().GetDataPoints()), 2)
+	for _, dp := range power.GetGauge().GetDataPoints() {
+		attrs := dp.GetAttributes()
+		// hostname and the custom labels are part of the resource
+		assert.Equal(t, len(attrs), 1)
+		assert.Equal(t, attrs[0].GetKey(), "gpu_id")
+		if attrs[0].GetValue().GetStringValue() == "0" {
+			assert.Equal(t, dp.GetAsDouble(), float64(250))
+		} else {
+			assert.Equal(t, dp.GetAsDouble(), float64(300))
+		}
+	}
+
+	energy := findMetric(req, "gpu_energy_consumed_total")
+	assert.Assert(t, energy != nil)
+	assert.Assert(t, energy.GetSum().GetIsMonotonic())
+	assert.Equal(t, energy.GetSum().GetAggregationTemporality(),
+		metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE)
+	dp := energy.GetSum().GetDataPoints()[0]
+	assert.Equal(t, dp.GetAsDouble(), float64(1000))
+	// counters without a created timestamp start with the process
+	assert.Equal(t, dp.GetStartTimeUnixNano(), uint64(testStartTime.UnixNano()))
+}
+
+func TestPushGRPC(t *testing.T) {
+	logger.Init(true)
+	recv := &receiver{}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Assert(t, err == nil)
+	srv := grpc.NewServer()
+	collectorpb.RegisterMetricsServiceServer(srv, recv)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	p, err := NewPusher(&exportermetrics.OTLPConfig{
+		Endpoint:           lis.Addr().String(),
+		Insecure:           true,
+		Headers:            map[string]string{"x-tenant": "gpu"},
+		ResourceAttributes: map[string]string{"k8s.cluster.name": "test"},
+	}, newTestRegistry(t), time.Minute, []string{"rack"}, testStartTime)
+	assert.Assert(t, err == nil, "pusher create failed %v", err)
+	assert.Assert(t, p.Push(context.Background()) == nil)
+	verifyRequest(t, recv.last())
+	assert.DeepEqual(t, recv.headers, []string{"gpu"})
+}
+
+func TestPushHTTP(t *testing.T) {
+	logger.Init(true)
+	recv := &receiver{}
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+
+	p, err := NewPusher(&exportermetrics.OTLPConfig{
+		Endpoint:           srv.URL,
+		Protocol:           ProtocolHTTP,
+		PushInterval:       1,
+		Headers:            map[string]string{"X-Tenant": "gpu"},
+		ResourceAttributes: map[string]string{"k8s.cluster.name": "test"},
+	}, newTestRegistry(t), time.Minute, []string{"rack"}, testStartTime)
+	assert.Assert(t, err == nil, "pusher create failed %v", err)
+	assert.Equal(t, p.interval, time.Second)
+
+	// pushes on every interval until cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	go p.Run(ctx)
+	deadline := time.Now().Add(5 * time.Second)
+	for recv.last() == nil && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	cancel()
+	verifyRequest(t, recv.last())
+	assert.Equal(t, recv.headers[0], "gpu")
+
+	// invalid configs
+	_, err = NewPusher(&exportermetrics.OTLPConfig{}, newTestRegistry(t), time.Minute, nil, time.Now())
+	assert.Assert(t, err != nil, "expecting missing endpoint error")
+	_, err = NewPusher(&exportermetrics.OTLPConfig{Endpoint: srv.URL, Protocol: "udp"}, newTestRegistry(t), time.Minute, nil, time.Now())
+	assert.Assert(t, err != nil, "expecting invalid protocol error")
+}
@@ -0,0 +1,327 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package relabel applies prometheus relabel_config rules to gathered
+// metric families
+package relabel
+
+import (
+	"crypto/md5"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+// MetricNameLabel holds the metric name during relabeling
+const MetricNameLabel = "__name__"
+
+// relabel actions
+const (
+	Replace   = "replace"
+	Keep      = "keep"
+	Drop      = "drop"
+	HashMod   = "hashmod"
+	LabelMap  = "labelmap"
+	LabelDrop = "labeldrop"
+	LabelKeep = "labelkeep"
+	Lowercase = "lowercase"
+	Uppercase = "uppercase"
+)
+
+var (
+	labelNameRe  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	metricNameRe = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	// replace targets may use the regex groups, e.g. ${1}_total
+	targetRe = regexp.MustCompile(`^(?:[a-zA-Z_]|\$(?:\{\w+\}|\w+))+\w*$`)
+)
+
+// Rule is a compiled relabel config
+type Rule struct {
+	sourceLabels []string
+	separator    string
+	regex        *regexp.Regexp
+	modulus      uint64
+	targetLabel  string
+	replacement  string
+	action       string
+}
+
+// Rules are applied in order
+type Rules []*Rule
+
+// NewRule compiles a relabel config with the prometheus defaults
+func NewRule(cfg *exportermetrics.RelabelConfig) (*Rule, error) {
+	r := &Rule{
+		sourceLabels: cfg.GetSourceLabels(),
+		separator:    cfg.GetSeparator(),
+		modulus:      cfg.GetModulus(),
+		targetLabel:  cfg.GetTargetLabel(),
+		replacement:  cfg.GetReplacement(),
+		action:       strings.ToLower(cfg.GetAction()),
+	}
+	if r.separator == "" {
+		r.separator = ";"
+	}
+	if r.replacement == "" {
+		r.replacement = "$1"
+	}
+	if r.action == "" {
+		r.action = Replace
+	}
+	expr := cfg.GetRegex()
+	if expr == "" {
+		expr = "(.*)"
+	}
+	var err error
+	if r.regex, err = regexp.Compile("^(?:" + expr + ")$"); err != nil {
+		return nil, fmt.Errorf("invalid regex %v, %v", expr, err)
+	}
+	for _, label := range r.sourceLabels {
+		if !labelNameRe.MatchString(label) {
+			return nil, fmt.Errorf("invalid source label %v", label)
+		}
+	}
+	switch r.action {
+	case Replace:
+		if r.targetLabel == "" || !targetRe.MatchString(r.targetLabel) {
+			return nil, fmt.Errorf("invalid target label %q for %v", r.targetLabel, r.action)
+		}
+	case HashMod, Lowercase, Uppercase:
+		if !labelNameRe.MatchString(r.targetLabel) {
+			return nil, fmt.Errorf("invalid target label %q for %v", r.targetLabel, r.action)
+		}
+		if r.action == HashMod && r.modulus == 0 {
+			return nil, fmt.Errorf("modulus is required for %v", r.action)
+		}
+	case Keep, Drop, LabelMap, LabelDrop, LabelKeep:
+	default:
+		return nil, fmt.Errorf("unknown action %v", cfg.GetAction())
+	}
+	if r.action == LabelDrop || r.action == LabelKeep {
+		if len(r.sourceLabels) != 0 || r.targetLabel != "" {
+			return nil, fmt.Errorf("%v takes no source or target labels", r.action)
+		}
+	}
+	return r, nil
+}
+
+// Compile compiles the relabel configs, nil if there are none
+func Compile(cfgs []*exportermetrics.RelabelConfig) (Rules, error) {
+	if len(cfgs) == 0 {
+		return nil, nil
+	}
+	rules := make(Rules, 0, len(cfgs))
+	for i, cfg := range cfgs {
+		r, err := NewRule(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("RelabelConfigs[%v]: %v", i, err)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// Process relabels the labels in place, false if the series is dropped
+func (rs Rules) Process(labels map[string]string) bool {
+	for _, r := range rs {
+		if !r.process(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Rule) process(labels map[string]string) bool {
+	values := make([]string, len(r.sourceLabels))
+	for i, label := range r.sourceLabels {
+		values[i] = labels[label]
+	}
+	val := strings.Join(values, r.separator)
+
+	switch r.action {
+	case Keep:
+		return r.regex.MatchString(val)
+	case Drop:
+		return !r.regex.MatchString(val)
+	case Replace:
+		indexes := r.regex.FindStringSubmatchIndex(val)
+		if indexes == nil {
+			return true
+		}
+		target := string(r.regex.ExpandString(nil, r.targetLabel, val, indexes))
+		if !labelNameRe.MatchString(target) {
+			return true
+		}
+		res := string(r.regex.ExpandString(nil, r.replacement, val, indexes))
+		if res == "" {
+			delete(labels, target)
+		} else {
+			labels[target] = res
+		}
+	case HashMod:
+		labels[r.targetLabel] = fmt.Sprintf("%d", sum64(md5.Sum([]byte(val)))%r.modulus)
+	case Lowercase:
+		labels[r.targetLabel] = strings.ToLower(val)
+	case Uppercase:
+		labels[r.targetLabel] = strings.ToUpper(val)
+	case LabelMap:
+		mapped := map[string]string{}
+		for name, value := range labels {
+			if r.regex.MatchString(name) {
+				if target := r.regex.ReplaceAllString(name, r.replacement); labelNameRe.MatchString(target) {
+					mapped[target] = value
+				}
+			}
+		}
+		for name, value := range mapped {
+			labels[name] = value
+		}
+	case LabelDrop, LabelKeep:
+		for name := range labels {
+			if name != MetricNameLabel && r.regex.MatchString(name) == (r.action == LabelDrop) {
+				delete(labels, name)
+			}
+		}
+	}
+	return true
+}
+
+// sum64 is the hash of the prometheus hashmod action, so shards match the
+// ones of a prometheus relabel config
+func sum64(hash [md5.Size]byte) uint64 {
+	var s uint64
+	for i, b := range hash {
+		shift := uint64((md5.Size - 1 - i) * 8)
+		s |= uint64(b) << shift
+	}
+	return s
+}
+
+// Series returns the labels of a series including its name
+func Series(name string, m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.GetLabel())+1)
+	for _, l := range m.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	labels[MetricNameLabel] = name
+	return labels
+}
+
+// SeriesString formats the labels as name{label="value",...}
+func SeriesString(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		if name != MetricNameLabel {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, fmt.Sprintf("%v=%q", name, labels[name]))
+	}
+	return labels[MetricNameLabel] + "{" + strings.Join(pairs, ",") + "}"
+}
+
+// Relabel processes the series labels, nil if the series is dropped or
+// can't be exported. Labels starting with __ are removed once the rules ran
+func (rs Rules) Relabel(name string, m *dto.Metric) map[string]string {
+	labels := Series(name, m)
+	if !rs.Process(labels) {
+		return nil
+	}
+	for label := range labels {
+		if strings.HasPrefix(label, "__") && label != MetricNameLabel {
+			delete(labels, label)
+		}
+	}
+	if !metricNameRe.MatchString(labels[MetricNameLabel]) {
+		return nil
+	}
+	return labels
+}
+
+// Apply returns the relabeled families, the input is left as is. Series
+// renamed into a family of another type or duplicating a series are dropped
+func (rs Rules) Apply(families []*dto.MetricFamily) []*dto.MetricFamily {
+	if len(rs) == 0 {
+		return families
+	}
+	byName := map[string]*dto.MetricFamily{}
+	seen := map[string]bool{}
+	result := []*dto.MetricFamily{}
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			labels := rs.Relabel(mf.GetName(), m)
+			if labels == nil {
+				continue
+			}
+			key := SeriesString(labels)
+			if seen[key] {
+				continue
+			}
+			name := labels[MetricNameLabel]
+			out, ok := byName[name]
+			if !ok {
+				out = &dto.MetricFamily{
+					Name: &name,
+					Help: mf.Help,
+					Type: mf.Type,
+					Unit: mf.Unit,
+				}
+				byName[name] = out
+				result = append(result, out)
+			} else if out.GetType() != mf.GetType() {
+				continue
+			}
+			seen[key] = true
+			out.Metric = append(out.Metric, relabeledMetric(m, labels))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+	return result
+}
+
+// relabeledMetric returns a copy of m with the labels, the values are
+// shared as the gathered families are never modified
+func relabeledMetric(m *dto.Metric, labels map[string]string) *dto.Metric {
+	pairs := make([]*dto.LabelPair, 0, len(labels))
+	for name, value := range labels {
+		if name == MetricNameLabel {
+			continue
+		}
+		pairs = append(pairs, &dto.LabelPair{Name: &name, Value: &value})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].GetName() < pairs[j].GetName()
+	})
+	return &dto.Metric{
+		Label:       pairs,
+		Gauge:       m.Gauge,
+		Counter:     m.Counter,
+		Summary:     m.Summary,
+		Untyped:     m.Untyped,
+		Histogram:   m.Histogram,
+		TimestampMs: m.TimestampMs,
+	}
+}
@@ -0,0 +1,168 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package relabel
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+func gauge(name string, series ...map[string]string) *dto.MetricFamily {
+	mf := &dto.MetricFamily{Name: proto.String(name), Type: dto.MetricType_GAUGE.Enum()}
+	for i, labels := range series {
+		m := &dto.Metric{Gauge: &dto.Gauge{Value: proto.Float64(float64(i))}}
+		for k, v := range labels {
+			m.Label = append(m.Label, &dto.LabelPair{Name: proto.String(k), Value: proto.String(v)})
+		}
+		mf.Metric = append(mf.Metric, m)
+	}
+	return mf
+}
+
+func relabelSeries(t *testing.T, cfgs []*exportermetrics.RelabelConfig, name string, labels map[string]string) string {
+	rules, err := Compile(cfgs)
+	assert.Assert(t, err == nil, "%v", err)
+	out := rules.Relabel(name, gauge(name, labels).Metric[0])
+	if out == nil {
+		return ""
+	}
+	return SeriesString(out)
+}
+
+func TestRules(t *testing.T) {
+	labels := map[string]string{"gpu_id": "1", "hostname": "Node-1", "card_model": "mi300x"}
+	for _, tc := range []struct {
+		name     string
+		cfgs     []*exportermetrics.RelabelConfig
+		expected string
+	}{
+		{
+			name: "rename",
+			cfgs: []*exportermetrics.RelabelConfig{{
+				SourceLabels: []string{"__name__"},
+				Regex:        "gpu_(.*)",
+				TargetLabel:  "__name__",
+				Replacement:  "amd_gpu_$1",
+			}},
+			expected: `amd_gpu_power_usage{card_model="mi300x",gpu_id="1",hostname="Node-1"}`,
+		},
+		{
+			name: "rewrite and copy",
+			cfgs: []*exportermetrics.RelabelConfig{
+				{SourceLabels: []string{"hostname"}, Regex: "node-(.*)", TargetLabel: "node"},
+				{SourceLabels: []string{"hostname"}, TargetLabel: "host", Action: "lowercase"},
+				{SourceLabels: []string{"card_model", "gpu_id"}, Separator: "/", TargetLabel: "gpu"},
+			},
+			// the anchored regex is case sensitive and does not match
+			expected: `gpu_power_usage{card_model="mi300x",gpu="mi300x/1",gpu_id="1",host="node-1",hostname="Node-1"}`,
+		},
+		{
+			name: "keep",
+			cfgs: []*exportermetrics.RelabelConfig{
+				{SourceLabels: []string{"__name__"}, Regex: "gpu_power.*", Action: "keep"},
+			},
+			expected: `gpu_power_usage{card_model="mi300x",gpu_id="1",hostname="Node-1"}`,
+		},
+		{
+			name: "drop",
+			cfgs: []*exportermetrics.RelabelConfig{
+				{SourceLabels: []string{"__name__", "gpu_id"}, Regex: "gpu_power_usage;1", Action: "drop"},
+			},
+		},
+		{
+			name: "labelmap and labeldrop",
+			cfgs: []*exportermetrics.RelabelConfig{
+				{Regex: "(card_.*)", Replacement: "gpu_$1", Action: "labelmap"},
+				{Regex: "card_.*|hostname", Action: "labeldrop"},
+			},
+			expected: `gpu_power_usage{gpu_card_model="mi300x",gpu_id="1"}`,
+		},
+		{
+			name: "labelkeep and temporary labels",
+			cfgs: []*exportermetrics.RelabelConfig{
+				{SourceLabels: []string{"gpu_id"}, TargetLabel: "__tmp"},
+				{Regex: "__tmp", Action: "labelkeep"},
+				{SourceLabels: []string{"__tmp"}, TargetLabel: "id"},
+			},
+			expected: `gpu_power_usage{id="1"}`,
+		},
+	} {
+		assert.Equal(t, relabelSeries(t, tc.cfgs, "gpu_power_usage", labels), tc.expected, tc.name)
+	}
+
+	// hashmod shards match prometheus, keep the series of one shard
+	shard := []*exportermetrics.RelabelConfig{
+		{SourceLabels: []string{"hostname"}, Modulus: 4, TargetLabel: "__shard", Action: "hashmod"},
+	}
+	rules, err := Compile(shard)
+	assert.Assert(t, err == nil, "%v", err)
+	l := map[string]string{"hostname": "node-1"}
+	assert.Assert(t, rules.Process(l))
+	assert.Equal(t, l["__shard"], "2")
+	shard = append(shard, &exportermetrics.RelabelConfig{SourceLabels: []string{"__shard"}, Regex: "0", Action: "keep"})
+	assert.Equal(t, relabelSeries(t, shard, "gpu_power_usage", map[string]string{"hostname": "node-1"}), "")
+}
+
+func TestNewRule(t *testing.T) {
+	for _, cfg := range []*exportermetrics.RelabelConfig{
+		{Regex: "(", TargetLabel: "a"},
+		{SourceLabels: []string{"gpu-id"}, TargetLabel: "a"},
+		{SourceLabels: []string{"gpu_id"}},
+		{SourceLabels: []string{"gpu_id"}, TargetLabel: "a-b"},
+		{SourceLabels: []string{"gpu_id"}, TargetLabel: "shard", Action: "hashmod"},
+		{SourceLabels: []string{"gpu_id"}, Action: "uppercase"},
+		{SourceLabels: []string{"gpu_id"}, Action: "labeldrop"},
+		{Action: "copy"},
+	} {
+		_, err := NewRule(cfg)
+		assert.Assert(t, err != nil, "expecting an error for %v", cfg)
+	}
+	_, err := NewRule(&exportermetrics.RelabelConfig{SourceLabels: []string{"gpu_id"}, TargetLabel: "${1}_id"})
+	assert.Assert(t, err == nil, "%v", err)
+	_, err = Compile([]*exportermetrics.RelabelConfig{{Action: "keep"}, {Action: "copy"}})
+	assert.ErrorContains(t, err, "RelabelConfigs[1]")
+}
+
+func TestApply(t *testing.T) {
+	families := []*dto.MetricFamily{
+		gauge("gpu_power_usage", map[string]string{"gpu_id": "0"}, map[string]string{"gpu_id": "1"}),
+		gauge("gpu_clock", map[string]string{"gpu_id": "0"}),
+		{Name: proto.String("gpu_energy"), Type: dto.MetricType_COUNTER.Enum(),
+			Metric: []*dto.Metric{{Counter: &dto.Counter{Value: proto.Float64(1)}}}},
+	}
+	assert.Equal(t, len(Rules(nil).Apply(families)), 3)
+
+	rules, err := Compile([]*exportermetrics.RelabelConfig{
+		// merge everything into gpu_power_usage, dropping the gpu_id label
+		{SourceLabels: []string{"__name__"}, Regex: ".*", TargetLabel: "__name__", Replacement: "gpu_power_usage"},
+		{Regex: "gpu_id", Action: "labeldrop"},
+	})
+	assert.Assert(t, err == nil, "%v", err)
+	out := rules.Apply(families)
+	// the duplicate series and the counter are dropped
+	assert.Equal(t, len(out), 1)
+	assert.Equal(t, out[0].GetName(), "gpu_power_usage")
+	assert.Equal(t, len(out[0].GetMetric()), 1)
+	assert.Equal(t, out[0].GetMetric()[0].GetGauge().GetValue(), float64(0))
+	// the input is left as is
+	assert.Equal(t, families[0].GetMetric()[1].GetLabel()[0].GetValue(), "1")
+}
@@ -0,0 +1,372 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package remotewrite
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/remotewrite"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
+)
+
+const (
+	defaultWALMaxSizeMB      = 256
+	defaultMaxSamplesPerSend = 2000
+	sendTimeout              = 30 * time.Second
+	minBackoff               = 500 * time.Millisecond
+	maxBackoff               = 30 * time.Second
+
+	remoteWriteVersion = "0.1.0"
+	userAgent          = "amd-device-metrics-exporter"
+	metricNameLabel    = "__name__"
+)
+
+// Sender gathers the metrics every interval into the wal and sends them to
+// the remote write endpoint in batches, failed sends are retried with
+// backoff and the samples stay in the wal until acknowledged
+type Sender struct {
+	gatherer       prometheus.Gatherer
+	wal            *wal
+	client         *http.Client
+	url            string
+	headers        map[string]string
+	bearerFile     string
+	basicUser      string
+	basicPassFile  string
+	externalLabels map[string]string
+	interval       time.Duration
+	maxSamples     int
+	appended       chan struct{}
+}
+
+// NewSender creates the sender and opens its wal, defaultInterval is used
+// when the config doesn't set an interval
+func NewSender(conf *exportermetrics.RemoteWriteConfig, gatherer prometheus.Gatherer, defaultInterval time.Duration) (*Sender, error) {
+	if conf.GetURL() == "" {
+		return nil, fmt.Errorf("remote write configured without URL")
+	}
+	if !strings.HasPrefix(conf.GetURL(), "http://") && !strings.HasPrefix(conf.GetURL(), "https://") {
+		return nil, fmt.Errorf("invalid remote write URL %v", conf.GetURL())
+	}
+	if (conf.GetBasicAuthUser() != "") != (conf.GetBasicAuthPasswordFile() != "") {
+		return nil, fmt.Errorf("remote write basic auth requires both BasicAuthUser and BasicAuthPasswordFile")
+	}
+	if conf.GetBasicAuthUser() != "" && conf.GetBearerTokenFile() != "" {
+		return nil, fmt.Errorf("remote write BearerTokenFile and basic auth are mutually exclusive")
+	}
+	tlsConf := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: conf.GetInsecureSkipVerify(),
+	}
+	if caFile := conf.GetCAFile(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read remote write CA %v, %v", caFile, err)
+		}
+		tlsConf.RootCAs = x509.NewCertPool()
+		if !tlsConf.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates found in remote write CA %v", caFile)
+		}
+	}
+
+	walDir := conf.GetWALDir()
+	if walDir == "" {
+		walDir = globals.RemoteWriteWALDir
+	}
+	maxSizeMB := conf.GetWALMaxSizeMB()
+	if maxSizeMB == 0 {
+		maxSizeMB = defaultWALMaxSizeMB
+	}
+	w, err := openWAL(walDir, int64(maxSizeMB)<<20, defaultSegmentSize)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := defaultInterval
+	if conf.GetInterval() != 0 {
+		interval = time.Duration(conf.GetInterval()) * time.Second
+	}
+	maxSamples := int(conf.GetMaxSamplesPerSend())
+	if maxSamples == 0 {
+		maxSamples = defaultMaxSamplesPerSend
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConf
+	return &Sender{
+		gatherer:       gatherer,
+		wal:            w,
+		client:         &http.Client{Transport: transport, Timeout: sendTimeout},
+		url:            conf.GetURL(),
+		headers:        conf.GetHeaders(),
+		bearerFile:     conf.GetBearerTokenFile(),
+		basicUser:      conf.GetBasicAuthUser(),
+		basicPassFile:  conf.GetBasicAuthPasswordFile(),
+		externalLabels: conf.GetExternalLabels(),
+		interval:       interval,
+		maxSamples:     maxSamples,
+		appended:       make(chan struct{}, 1),
+	}, nil
+}
+
+// Run appends and sends until the context is cancelled, the wal is closed
+// on return
+func (s *Sender) Run(ctx context.Context) {
+	logger.Log.Printf("starting remote write to %v, interval %v", s.url, s.interval)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.sendLoop(ctx)
+	}()
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			<-done
+			s.wal.Close()
+			logger.Log.Printf("remote write stopped")
+			return
+		case <-ticker.C:
+			if err := s.Append(); err != nil {
+				logger.Log.Printf("remote write append err: %v", err)
+			}
+		}
+	}
+}
+
+// Append gathers the metrics and appends them to the wal
+func (s *Sender) Append() error {
+	families, err := s.gatherer.Gather()
+	if err != nil {
+		return fmt.Errorf("gather failed, %v", err)
+	}
+	req := s.convert(families, time.Now())
+	if len(req.Timeseries) == 0 {
+		return nil
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("write request marshal failed, %v", err)
+	}
+	if err := s.wal.Append(data); err != nil {
+		return err
+	}
+	select {
+	case s.appended <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// convert maps the gathered families to series with a single sample
+func (s *Sender) convert(families []*dto.MetricFamily, now time.Time) *remotewrite.WriteRequest {
+	ts := now.UnixMilli()
+	req := &remotewrite.WriteRequest{}
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			var value float64
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				value = m.GetCounter().GetValue()
+			case dto.MetricType_GAUGE:
+				value = m.GetGauge().GetValue()
+			case dto.MetricType_UNTYPED:
+				value = m.GetUntyped().GetValue()
+			default:
+				// summaries and histograms are not exported by the device
+				// collectors
+				continue
+			}
+			labels := map[string]string{metricNameLabel: mf.GetName()}
+			for k, v := range s.externalLabels {
+				labels[k] = v
+			}
+			// series labels win over external labels
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			req.Timeseries = append(req.Timeseries, &remotewrite.TimeSeries{
+				Labels:  sortedLabels(labels),
+				Samples: []*remotewrite.Sample{{Value: value, Timestamp: ts}},
+			})
+		}
+	}
+	return req
+}
+
+func sortedLabels(m map[string]string) []*remotewrite.Label {
+	labels := make([]*remotewrite.Label, 0, len(m))
+	for k, v := range m {
+		if v == "" {
+			continue
+		}
+		labels = append(labels, &remotewrite.Label{Name: k, Value: v})
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Name < labels[j].Name
+	})
+	return labels
+}
+
+// sendLoop sends the wal records in batches of up to max samples, the wal
+// position is committed once a batch is acknowledged
+func (s *Sender) sendLoop(ctx context.Context) {
+	for ctx.Err() == nil {
+		series, next, err := s.nextBatch()
+		if err != nil && err != io.EOF {
+			logger.Log.Printf("remote write wal read err: %v", err)
+		}
+		if len(series) == 0 {
+			if next != s.wal.Position() {
+				// skipped empty or corrupt records
+				_ = s.wal.Commit(next)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.appended:
+			}
+			continue
+		}
+		if !s.sendWithRetry(ctx, series) {
+			// cancelled, resent on the next run
+			return
+		}
+		if err := s.wal.Commit(next); err != nil {
+			logger.Log.Printf("remote write commit err: %v", err)
+		}
+	}
+}
+
+// nextBatch reads records from the wal position until the batch holds at
+// least max samples, records are never split across batches
+func (s *Sender) nextBatch() ([]*remotewrite.TimeSeries, walPos, error) {
+	pos := s.wal.Position()
+	var series []*remotewrite.TimeSeries
+	for len(series) < s.maxSamples {
+		data, next, err := s.wal.Read(pos)
+		if err != nil {
+			return series, pos, err
+		}
+		pos = next
+		req := &remotewrite.WriteRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			selfmetrics.RemoteWriteWALCorrupt()
+			logger.Log.Printf("remote write dropping undecodable wal record, %v", err)
+			continue
+		}
+		series = append(series, req.Timeseries...)
+	}
+	return series, pos, nil
+}
+
+// sendWithRetry sends the series in requests of up to max samples, returns
+// false only when cancelled
+func (s *Sender) sendWithRetry(ctx context.Context, series []*remotewrite.TimeSeries) bool {
+	for start := 0; start < len(series); start += s.maxSamples {
+		end := start + s.maxSamples
+		if end > len(series) {
+			end = len(series)
+		}
+		backoff := minBackoff
+		for {
+			retry, err := s.send(ctx, series[start:end])
+			if err == nil {
+				break
+			}
+			if !retry {
+				logger.Log.Printf("remote write dropping %v samples, %v", end-start, err)
+				break
+			}
+			logger.Log.Printf("remote write err: %v, retrying in %v", err, backoff)
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+		}
+	}
+	return true
+}
+
+// send writes a single request, returns true if the error is retryable
+func (s *Sender) send(ctx context.Context, series []*remotewrite.TimeSeries) (bool, error) {
+	data, err := proto.Marshal(&remotewrite.WriteRequest{Timeseries: series})
+	if err != nil {
+		return false, fmt.Errorf("write request marshal failed, %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return false, err
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+	// credentials are read on every send to pick up rotated secrets
+	if s.bearerFile != "" {
+		token, err := os.ReadFile(s.bearerFile)
+		if err != nil {
+			return true, fmt.Errorf("failed to read bearer token, %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	} else if s.basicUser != "" {
+		pass, err := os.ReadFile(s.basicPassFile)
+		if err != nil {
+			return true, fmt.Errorf("failed to read basic auth password, %v", err)
+		}
+		req.SetBasicAuth(s.basicUser, strings.TrimSpace(string(pass)))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+	err = fmt.Errorf("status %v, %v", resp.Status, strings.TrimSpace(string(body)))
+	// as per the spec 5xx and 429 are retried, other errors are dropped
+	return resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests, err
+}
@@ -0,0 +1,236 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package remotewrite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/remotewrite"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func TestWAL(t *testing.T) {
+	logger.Init(true)
+	dir := t.TempDir()
+
+	w, err := openWAL(dir, 1<<20, 64)
+	assert.Assert(t, err == nil, "wal open failed %v", err)
+	for i := 0; i < 5; i++ {
+		assert.Assert(t, w.Append([]byte(fmt.Sprintf("record-%d-%s", i, make([]byte, 40)))) == nil)
+	}
+	// records are read in order across segments
+	pos := w.Position()
+	for i := 0; i < 5; i++ {
+		data, next, err := w.Read(pos)
+		assert.Assert(t, err == nil, "read %v failed %v", i, err)
+		assert.Equal(t, string(data[:8]), fmt.Sprintf("record-%d", i))
+		pos = next
+		if i == 1 {
+			assert.Assert(t, w.Commit(pos) == nil)
+		}
+	}
+	_, _, err = w.Read(pos)
+	assert.Equal(t, err, io.EOF)
+	w.Close()
+
+	// position survives a restart, sent segments are removed
+	w, err = openWAL(dir, 1<<20, 64)
+	assert.Assert(t, err == nil, "wal reopen failed %v", err)
+	data, _, err := w.Read(w.Position())
+	assert.Assert(t, err == nil)
+	assert.Equal(t, string(data[:8]), "record-2")
+	_, err = os.Stat(segmentPath(dir, 1))
+	assert.Assert(t, os.IsNotExist(err), "sent segment not removed")
+
+	// a corrupt segment skips to the next one
+	assert.Assert(t, w.Append([]byte(fmt.Sprintf("record-5-%s", make([]byte, 40)))) == nil)
+	assert.Assert(t, os.WriteFile(segmentPath(dir, 3), []byte("garbage!garbage!"), 0600) == nil)
+	w.sizes[3] = 16
+	data, _, err = w.Read(walPos{seg: 3})
+	assert.Assert(t, err == nil, "read failed %v", err)
+	assert.Equal(t, string(data[:8]), "record-5")
+
+	// a corrupt record of the written segment starts a new one
+	last := w.segments[len(w.segments)-1]
+	f, err := os.OpenFile(segmentPath(dir, last), os.O_WRONLY|os.O_APPEND, 0600)
+	assert.Assert(t, err == nil)
+	_, err = f.Write([]byte("torn"))
+	assert.Assert(t, err == nil)
+	f.Close()
+	pos = walPos{seg: last, off: w.sizes[last]}
+	w.sizes[last] += 4
+	_, pos, err = w.Read(pos)
+	assert.Equal(t, err, io.EOF)
+	assert.Assert(t, pos.seg > last, "expecting a new segment")
+	assert.Assert(t, w.Append([]byte(fmt.Sprintf("record-6-%s", make([]byte, 40)))) == nil)
+	data, _, err = w.Read(pos)
+	assert.Assert(t, err == nil, "read failed %v", err)
+	assert.Equal(t, string(data[:8]), "record-6")
+	w.Close()
+
+	// oldest unsent segments are dropped beyond the max size
+	w, err = openWAL(t.TempDir(), 256, 64)
+	assert.Assert(t, err == nil)
+	for i := 0; i < 20; i++ {
+		assert.Assert(t, w.Append([]byte(fmt.Sprintf("record-%02d-%s", i, make([]byte, 40)))) == nil)
+	}
+	assert.Assert(t, w.size() <= 256+64, "wal size %v", w.size())
+	data, _, err = w.Read(w.Position())
+	assert.Assert(t, err == nil)
+	assert.Assert(t, string(data[:9]) != "record-00", "oldest record not dropped")
+	w.Close()
+}
+
+// receiver is a remote write endpoint failing the first requests
+type receiver struct {
+	sync.Mutex
+	failures int
+	requests int
+	samples  []float64
+	labels   []map[string]string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+	r.requests++
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if req.Header.Get("Content-Encoding") != "snappy" || req.Header.Get("X-Prometheus-Remote-Write-Version") == "" ||
+		req.Header.Get("Authorization") != "Bearer secret" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	compressed, _ := io.ReadAll(req.Body)
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	wr := &remotewrite.WriteRequest{}
+	if err := proto.Unmarshal(data, wr); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for _, ts := range wr.Timeseries {
+		l := map[string]string{}
+		for _, label := range ts.Labels {
+			l[label.Name] = label.Value
+		}
+		r.labels = append(r.labels, l)
+		r.samples = append(r.samples, ts.Samples[0].Value)
+	}
+}
+
+func (r *receiver) received() []float64 {
+	r.Lock()
+	defer r.Unlock()
+	return append([]float64{}, r.samples...)
+}
+
+func waitSamples(t *testing.T, r *receiver, n int) {
+	deadline := time.Now().Add(10 * time.Second)
+	for len(r.received()) < n && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.Equal(t, len(r.received()), n)
+}
+
+func TestSender(t *testing.T) {
+	logger.Init(true)
+	recv := &receiver{failures: 2}
+	srv := httptest.NewServer(recv)
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.Assert(t, os.WriteFile(tokenFile, []byte("secret\n"), 0600) == nil)
+	reg := prometheus.NewRegistry()
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "gpu_power_usage"}, []string{"gpu_id"})
+	assert.Assert(t, reg.Register(gauge) == nil)
+
+	conf := &exportermetrics.RemoteWriteConfig{
+		URL:               srv.URL,
+		BearerTokenFile:   tokenFile,
+		WALDir:            t.TempDir(),
+		MaxSamplesPerSend: 2,
+		ExternalLabels:    map[string]string{"cluster": "c1"},
+	}
+	s, err := NewSender(conf, reg, time.Hour)
+	assert.Assert(t, err == nil, "sender create failed %v", err)
+
+	// samples appended during the outage are sent in order once it is over
+	for i := 0; i < 3; i++ {
+		gauge.WithLabelValues("0").Set(float64(i))
+		assert.Assert(t, s.Append() == nil)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+	waitSamples(t, recv, 3)
+	assert.DeepEqual(t, recv.received(), []float64{0, 1, 2})
+	assert.DeepEqual(t, recv.labels[0], map[string]string{
+		"__name__": "gpu_power_usage", "gpu_id": "0", "cluster": "c1"})
+	// two series per request at most
+	gauge.WithLabelValues("1").Set(10)
+	gauge.WithLabelValues("2").Set(20)
+	assert.Assert(t, s.Append() == nil)
+	waitSamples(t, recv, 6)
+	cancel()
+	<-done
+
+	// unsent samples of a previous run are sent after a restart
+	s, err = NewSender(conf, reg, time.Hour)
+	assert.Assert(t, err == nil)
+	gauge.Reset()
+	gauge.WithLabelValues("0").Set(100)
+	assert.Assert(t, s.Append() == nil)
+	s.wal.Close()
+	s, err = NewSender(conf, reg, time.Hour)
+	assert.Assert(t, err == nil)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+	waitSamples(t, recv, 7)
+	assert.Equal(t, recv.received()[6], float64(100))
+
+	// invalid configs
+	_, err = NewSender(&exportermetrics.RemoteWriteConfig{}, reg, time.Hour)
+	assert.Assert(t, err != nil, "expecting missing url error")
+	_, err = NewSender(&exportermetrics.RemoteWriteConfig{URL: srv.URL, BasicAuthUser: "u"}, reg, time.Hour)
+	assert.Assert(t, err != nil, "expecting missing password file error")
+}
@@ -0,0 +1,328 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package remotewrite
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
+)
+
+const (
+	// record header, payload length and crc32 of the payload
+	recordHeaderSize = 8
+	// records beyond this size are treated as corrupt
+	maxRecordSize = 64 << 20
+
+	defaultSegmentSize = 8 << 20
+	positionFile       = "position"
+	segmentSuffix      = ".seg"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// walPos is the position of a record in the wal
+type walPos struct {
+	seg int
+	off int64
+}
+
+// wal is an append only log of encoded write requests split in numbered
+// segments, the position of the first unsent record is persisted so samples
+// survive restarts and endpoint outages
+type wal struct {
+	sync.Mutex
+	dir         string
+	segmentSize int64
+	maxSize     int64
+	segments    []int         // sorted segment ids, the last one is written
+	sizes       map[int]int64 // segment id -> size
+	w           *os.File      // last segment
+	readers     map[int]*os.File
+	pos         walPos // first unsent record
+}
+
+func segmentPath(dir string, seg int) string {
+	return filepath.Join(dir, fmt.Sprintf("%08d%s", seg, segmentSuffix))
+}
+
+// openWAL opens the wal in dir, a new segment is always started so a torn
+// write of a previous run is never appended to
+func openWAL(dir string, maxSize, segmentSize int64) (*wal, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create wal directory %v, %v", dir, err)
+	}
+	if segmentSize > maxSize/2 {
+		segmentSize = maxSize / 2
+	}
+	w := &wal{
+		dir:         dir,
+		segmentSize: segmentSize,
+		maxSize:     maxSize,
+		sizes:       make(map[int]int64),
+		readers:     make(map[int]*os.File),
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wal directory %v, %v", dir, err)
+	}
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), segmentSuffix) {
+			continue
+		}
+		seg, err := strconv.Atoi(strings.TrimSuffix(e.Name(), segmentSuffix))
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		w.segments = append(w.segments, seg)
+		w.sizes[seg] = info.Size()
+	}
+	sort.Ints(w.segments)
+	if n := len(w.segments); n != 0 && w.sizes[w.segments[n-1]] == 0 {
+		// reuse the empty segment of the previous run
+		_ = os.Remove(segmentPath(dir, w.segments[n-1]))
+		delete(w.sizes, w.segments[n-1])
+		w.segments = w.segments[:n-1]
+	}
+	w.pos = w.readPosition()
+	if err := w.roll(); err != nil {
+		return nil, err
+	}
+	w.truncate()
+	return w, nil
+}
+
+func (w *wal) readPosition() walPos {
+	data, err := os.ReadFile(filepath.Join(w.dir, positionFile))
+	if err != nil {
+		return walPos{}
+	}
+	var pos walPos
+	if _, err := fmt.Sscanf(string(data), "%d %d", &pos.seg, &pos.off); err != nil {
+		logger.Log.Printf("invalid wal position %q, resending from the start", string(data))
+		return walPos{}
+	}
+	return pos
+}
+
+// roll starts a new segment, called with the lock held
+func (w *wal) roll() error {
+	next := 1
+	if len(w.segments) != 0 {
+		next = w.segments[len(w.segments)-1] + 1
+	}
+	f, err := os.OpenFile(segmentPath(w.dir, next), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create wal segment, %v", err)
+	}
+	if w.w != nil {
+		w.w.Close()
+	}
+	w.w = f
+	w.segments = append(w.segments, next)
+	w.sizes[next] = 0
+	return nil
+}
+
+// size returns the total size of the segments, called with the lock held
+func (w *wal) size() int64 {
+	var total int64
+	for _, seg := range w.segments {
+		total += w.sizes[seg]
+	}
+	return total
+}
+
+// truncate drops the oldest segments beyond the max size and the ones
+// already sent, called with the lock held
+func (w *wal) truncate() {
+	for len(w.segments) > 1 {
+		oldest := w.segments[0]
+		sent := oldest < w.pos.seg
+		if !sent && w.size() <= w.maxSize {
+			return
+		}
+		if !sent {
+			logger.Log.Printf("remote write wal full, dropping %v bytes of unsent samples", w.sizes[oldest])
+		}
+		if r, ok := w.readers[oldest]; ok {
+			r.Close()
+			delete(w.readers, oldest)
+		}
+		if err := os.Remove(segmentPath(w.dir, oldest)); err != nil && !os.IsNotExist(err) {
+			logger.Log.Printf("failed to remove wal segment %v, %v", oldest, err)
+		}
+		delete(w.sizes, oldest)
+		w.segments = w.segments[1:]
+	}
+}
+
+// Append writes a record to the wal
+func (w *wal) Append(data []byte) error {
+	w.Lock()
+	defer w.Unlock()
+	if w.sizes[w.segments[len(w.segments)-1]] >= w.segmentSize {
+		if err := w.roll(); err != nil {
+			return err
+		}
+	}
+	buf := make([]byte, recordHeaderSize+len(data))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.Checksum(data, crcTable))
+	copy(buf[recordHeaderSize:], data)
+	n, err := w.w.Write(buf)
+	w.sizes[w.segments[len(w.segments)-1]] += int64(n)
+	if err != nil {
+		return fmt.Errorf("wal write failed, %v", err)
+	}
+	if err := w.w.Sync(); err != nil {
+		return fmt.Errorf("wal sync failed, %v", err)
+	}
+	w.truncate()
+	return nil
+}
+
+func (w *wal) reader(seg int) (*os.File, error) {
+	if r, ok := w.readers[seg]; ok {
+		return r, nil
+	}
+	r, err := os.Open(segmentPath(w.dir, seg))
+	if err != nil {
+		return nil, err
+	}
+	w.readers[seg] = r
+	return r, nil
+}
+
+// Position returns the position of the first unsent record
+func (w *wal) Position() walPos {
+	w.Lock()
+	defer w.Unlock()
+	return w.pos
+}
+
+// Read returns the record at pos and the position of the next one, io.EOF
+// when there are no more records. Corrupt records skip the rest of their
+// segment, a new segment is started first if it is the written one so the
+// next records are appended past the corruption. Dropped segments continue
+// from the oldest one left.
+func (w *wal) Read(pos walPos) ([]byte, walPos, error) {
+	w.Lock()
+	defer w.Unlock()
+	for {
+		idx := sort.SearchInts(w.segments, pos.seg)
+		if idx == len(w.segments) {
+			// stale position of a removed wal
+			idx = 0
+		}
+		if w.segments[idx] != pos.seg {
+			// segment was dropped
+			pos = walPos{seg: w.segments[idx]}
+		}
+		last := idx == len(w.segments)-1
+		if pos.off >= w.sizes[pos.seg] {
+			if last {
+				return nil, pos, io.EOF
+			}
+			pos = walPos{seg: w.segments[idx+1]}
+			continue
+		}
+		data, err := w.readRecord(pos)
+		if err == nil {
+			return data, walPos{seg: pos.seg, off: pos.off + recordHeaderSize + int64(len(data))}, nil
+		}
+		selfmetrics.RemoteWriteWALCorrupt()
+		logger.Log.Printf("wal segment %v corrupt at %v, %v, skipping to the next segment", pos.seg, pos.off, err)
+		if last {
+			// e.g. a torn write, the record boundaries after it are lost
+			if rerr := w.roll(); rerr != nil {
+				return nil, pos, rerr
+			}
+		}
+		pos = walPos{seg: w.segments[idx+1]}
+	}
+}
+
+// readRecord reads a single record, called with the lock held
+func (w *wal) readRecord(pos walPos) ([]byte, error) {
+	r, err := w.reader(pos.seg)
+	if err != nil {
+		return nil, err
+	}
+	hdr := make([]byte, recordHeaderSize)
+	if _, err := r.ReadAt(hdr, pos.off); err != nil {
+		return nil, fmt.Errorf("short record header, %v", err)
+	}
+	length := binary.BigEndian.Uint32(hdr[0:4])
+	if length > maxRecordSize {
+		return nil, fmt.Errorf("invalid record length %v", length)
+	}
+	data := make([]byte, length)
+	if _, err := r.ReadAt(data, pos.off+recordHeaderSize); err != nil {
+		return nil, fmt.Errorf("short record, %v", err)
+	}
+	if crc32.Checksum(data, crcTable) != binary.BigEndian.Uint32(hdr[4:8]) {
+		return nil, fmt.Errorf("record checksum mismatch")
+	}
+	return data, nil
+}
+
+// Commit marks the records before pos as sent
+func (w *wal) Commit(pos walPos) error {
+	w.Lock()
+	defer w.Unlock()
+	if idx := sort.SearchInts(w.segments, pos.seg); idx < len(w.segments)-1 &&
+		w.segments[idx] == pos.seg && pos.off >= w.sizes[pos.seg] {
+		// fully sent segment, let it be removed
+		pos = walPos{seg: w.segments[idx+1]}
+	}
+	w.pos = pos
+	tmp := filepath.Join(w.dir, positionFile+".tmp")
+	if err := os.WriteFile(tmp, []byte(fmt.Sprintf("%d %d", pos.seg, pos.off)), 0600); err != nil {
+		return fmt.Errorf("wal position write failed, %v", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(w.dir, positionFile)); err != nil {
+		return fmt.Errorf("wal position write failed, %v", err)
+	}
+	w.truncate()
+	return nil
+}
+
+// Close closes the segment files
+func (w *wal) Close() error {
+	w.Lock()
+	defer w.Unlock()
+	for seg, r := range w.readers {
+		r.Close()
+		delete(w.readers, seg)
+	}
+	return w.w.Close()
+}
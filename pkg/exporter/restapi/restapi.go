@@ -0,0 +1,199 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package restapi serves the versioned JSON REST API of the GPU inventory,
+// stats and health on the metrics server
+package restapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// PathPrefix of the v1 API routes
+	PathPrefix = "/api/v1"
+
+	HealthHealthy   = "healthy"
+	HealthUnhealthy = "unhealthy"
+	HealthUnknown   = "unknown"
+
+	WorkloadKubernetes = "kubernetes"
+	WorkloadSlurm      = "slurm"
+)
+
+//go:embed openapi.yaml
+var openAPIDoc []byte
+
+// Partition of a GPU, compute and memory partition types are the ones of
+// the physical GPU
+type Partition struct {
+	ID                   uint32 `json:"id"`
+	ComputePartitionType string `json:"compute_partition_type,omitempty"`
+	MemoryPartitionType  string `json:"memory_partition_type,omitempty"`
+}
+
+// Health of a GPU as reported to the device plugin
+type Health struct {
+	State       string            `json:"state"`
+	Reasons     []string          `json:"reasons,omitempty"`
+	ErrorCounts map[string]uint64 `json:"error_counts,omitempty"`
+}
+
+// Workload using a GPU, pod fields are set for kubernetes and job fields
+// for slurm
+type Workload struct {
+	Type         string `json:"type"`
+	Pod          string `json:"pod,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Container    string `json:"container,omitempty"`
+	JobID        string `json:"job_id,omitempty"`
+	JobUser      string `json:"job_user,omitempty"`
+	JobPartition string `json:"job_partition,omitempty"`
+	Cluster      string `json:"cluster,omitempty"`
+}
+
+// GPU is the normalized snapshot of a GPU, spec, status and stats are the
+// proto3 JSON encoding of the gpuagent objects
+type GPU struct {
+	ID           string          `json:"id"`
+	UUID         string          `json:"uuid"`
+	SerialNumber string          `json:"serial_number,omitempty"`
+	CardModel    string          `json:"card_model,omitempty"`
+	PCIeBusID    string          `json:"pcie_bus_id,omitempty"`
+	Partition    Partition       `json:"partition"`
+	Health       Health          `json:"health"`
+	Workloads    []Workload      `json:"workloads"`
+	Spec         json.RawMessage `json:"spec,omitempty"`
+	Status       json.RawMessage `json:"status,omitempty"`
+	Stats        json.RawMessage `json:"stats,omitempty"`
+}
+
+// GPUList is the response of /gpus
+type GPUList struct {
+	GPUs []*GPU `json:"gpus"`
+}
+
+// GPUHealth is the health of a single GPU in /health
+type GPUHealth struct {
+	ID     string `json:"id"`
+	UUID   string `json:"uuid"`
+	Health Health `json:"health"`
+}
+
+// NodeHealth is the response of /health, the node is unhealthy when any
+// of its GPUs is
+type NodeHealth struct {
+	State string       `json:"state"`
+	GPUs  []*GPUHealth `json:"gpus"`
+}
+
+// Error is the body of the error responses
+type Error struct {
+	Error string `json:"error"`
+}
+
+// Source provides the GPU snapshots served by the API
+type Source interface {
+	ListGPUs() ([]*GPU, error)
+}
+
+// RegisterRoutes adds the v1 API routes to the router
+func RegisterRoutes(router *mux.Router, src Source) {
+	api := router.PathPrefix(PathPrefix).Methods(http.MethodGet).Subrouter()
+	api.HandleFunc("/gpus", listGPUs(src))
+	api.HandleFunc("/gpus/{id}", getGPU(src))
+	api.HandleFunc("/health", getHealth(src))
+	api.HandleFunc("/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(openAPIDoc)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Log.Printf("api response write failed, %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, &Error{Error: err.Error()})
+}
+
+func listGPUs(src Source) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gpus, err := src.ListGPUs()
+		if err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, &GPUList{GPUs: gpus})
+	}
+}
+
+// getGPU serves a GPU by gpu_id or uuid
+func getGPU(src Source) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+		gpus, err := src.ListGPUs()
+		if err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		for _, gpu := range gpus {
+			if gpu.ID == id || strings.EqualFold(gpu.UUID, id) {
+				writeJSON(w, http.StatusOK, gpu)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, fmt.Errorf("gpu %v not found", id))
+	}
+}
+
+func getHealth(src Source) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gpus, err := src.ListGPUs()
+		if err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		resp := &NodeHealth{State: HealthHealthy, GPUs: []*GPUHealth{}}
+		if len(gpus) == 0 {
+			resp.State = HealthUnknown
+		}
+		for _, gpu := range gpus {
+			resp.GPUs = append(resp.GPUs, &GPUHealth{ID: gpu.ID, UUID: gpu.UUID, Health: gpu.Health})
+			switch gpu.Health.State {
+			case HealthUnhealthy:
+				resp.State = HealthUnhealthy
+			case HealthUnknown:
+				if resp.State == HealthHealthy {
+					resp.State = HealthUnknown
+				}
+			}
+		}
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,88 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+type fakeSource struct {
+	gpus []*GPU
+	err  error
+}
+
+func (f *fakeSource) ListGPUs() ([]*GPU, error) {
+	return f.gpus, f.err
+}
+
+func get(t *testing.T, router *mux.Router, path string, v interface{}) int {
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if v != nil {
+		assert.Equal(t, rec.Header().Get("Content-Type"), "application/json")
+		assert.Assert(t, json.Unmarshal(rec.Body.Bytes(), v) == nil, "invalid json %v", rec.Body.String())
+	}
+	return rec.Code
+}
+
+func TestAPI(t *testing.T) {
+	logger.Init(true)
+	src := &fakeSource{gpus: []*GPU{
+		{ID: "0", UUID: "AA-0", Health: Health{State: HealthHealthy}, Workloads: []Workload{},
+			Stats: json.RawMessage(`{"PackagePower":"41"}`)},
+		{ID: "1", UUID: "aa-1", Health: Health{State: HealthUnhealthy, Reasons: []string{"compute node marked unhealthy"}},
+			Workloads: []Workload{{Type: WorkloadKubernetes, Pod: "p", Namespace: "ns"}}},
+	}}
+	router := mux.NewRouter()
+	RegisterRoutes(router, src)
+
+	list := &GPUList{}
+	assert.Equal(t, get(t, router, "/api/v1/gpus", list), http.StatusOK)
+	assert.Equal(t, len(list.GPUs), 2)
+	assert.Equal(t, string(list.GPUs[0].Stats), `{"PackagePower":"41"}`)
+
+	// by gpu_id or uuid
+	gpu := &GPU{}
+	assert.Equal(t, get(t, router, "/api/v1/gpus/1", gpu), http.StatusOK)
+	assert.Equal(t, gpu.Workloads[0].Pod, "p")
+	assert.Equal(t, get(t, router, "/api/v1/gpus/aa-0", gpu), http.StatusOK)
+	assert.Equal(t, gpu.ID, "0")
+	apiErr := &Error{}
+	assert.Equal(t, get(t, router, "/api/v1/gpus/7", apiErr), http.StatusNotFound)
+	assert.Equal(t, apiErr.Error, "gpu 7 not found")
+
+	health := &NodeHealth{}
+	assert.Equal(t, get(t, router, "/api/v1/health", health), http.StatusOK)
+	assert.Equal(t, health.State, HealthUnhealthy)
+	assert.DeepEqual(t, health.GPUs[1].Health.Reasons, []string{"compute node marked unhealthy"})
+
+	assert.Equal(t, get(t, router, "/api/v1/openapi.yaml", nil), http.StatusOK)
+
+	// gpuagent down
+	src.err = fmt.Errorf("gpuagent get failed")
+	assert.Equal(t, get(t, router, "/api/v1/gpus", apiErr), http.StatusServiceUnavailable)
+	assert.Equal(t, apiErr.Error, "gpuagent get failed")
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/selfmetrics"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 	kube "k8s.io/kubelet/pkg/apis/podresources/v1alpha1"
 
@@ -63,7 +65,9 @@ func (pr *podResourcesClient) ListWorkloads() (map[string]Workload, error) {
 	prCl := kube.NewPodResourcesListerClient(pr.clientConn)
 	ctx, cancel := context.WithTimeout(pr.ctx, time.Second*10)
 	defer cancel()
+	start := time.Now()
 	resp, err := prCl.List(ctx, &kube.ListPodResourcesRequest{})
+	selfmetrics.ObservePodResources(start, err)
 	if err != nil {
 		logger.Log.Printf("failed to list pod resources, %v", err)
 		return nil, fmt.Errorf("failed to list pod resources, %v", err)
@@ -107,3 +111,10 @@ func (cl *podResourcesClient) Close() error {
 func (cl *podResourcesClient) Type() SchedulerType {
 	return Kubernetes
 }
+
+func (cl *podResourcesClient) Healthy() error {
+	if state := cl.clientConn.GetState(); state == connectivity.TransientFailure || state == connectivity.Shutdown {
+		return fmt.Errorf("kubelet pod resources connection %v", state)
+	}
+	return nil
+}
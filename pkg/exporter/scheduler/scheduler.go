@@ -34,6 +34,8 @@ type SchedulerClient interface {
 	CheckExportLabels(labels map[string]bool) bool
 	Close() error
 	Type() SchedulerType
+	// Healthy returns an error when workloads can't be tracked
+	Healthy() error
 }
 
 type PodResourceInfo struct {
@@ -21,10 +21,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
@@ -41,10 +44,11 @@ var SlurmLabels = map[string]bool{
 
 type client struct {
 	sync.Mutex
-	zmqSock zmq.Socket
-	GpuJobs map[string]JobInfo
-	ctx     context.Context
-	cancel  context.CancelFunc
+	zmqSock  zmq.Socket
+	GpuJobs  map[string]JobInfo
+	ctx      context.Context
+	cancel   context.CancelFunc
+	watching atomic.Bool // job directory watcher running
 }
 
 // NewSlurmClient - creates a slurm schedler client
@@ -81,6 +85,7 @@ func NewSlurmClient(ctx context.Context, enableZmq bool) (SchedulerClient, error
 
 		// Start listening for events.
 		go func() {
+			defer cl.watching.Store(false)
 			for cl.ctx.Err() == nil {
 				select {
 				case event, ok := <-watcher.Events:
@@ -121,6 +126,7 @@ func NewSlurmClient(ctx context.Context, enableZmq bool) (SchedulerClient, error
 		if err != nil {
 			logger.Log.Fatalf("fsnotify watch err: %v", err)
 		}
+		cl.watching.Store(true)
 
 		// read existing
 		if fds, err := os.ReadDir(globals.SlurmDir); err == nil {
@@ -131,6 +137,7 @@ func NewSlurmClient(ctx context.Context, enableZmq bool) (SchedulerClient, error
 
 		// Block main goroutine forever.
 		<-cl.ctx.Done()
+		cl.watching.Store(false)
 		logger.Log.Printf("slurm job watcher stopped")
 	}()
 
@@ -201,3 +208,31 @@ func (cl *client) Close() error {
 func (cl *client) Type() SchedulerType {
 	return Slurm
 }
+
+func (cl *client) Healthy() error {
+	if !cl.watching.Load() {
+		return fmt.Errorf("slurm job watcher on %v not running", globals.SlurmDir)
+	}
+	return nil
+}
+
+// GetNodePartitions returns the slurm partitions of the node from scontrol
+func GetNodePartitions(node string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "scontrol", "show", "node", node, "--oneliner").Output()
+	if err != nil {
+		return nil, fmt.Errorf("scontrol show node %v failed, %v", node, err)
+	}
+	return parseNodePartitions(string(out)), nil
+}
+
+// parseNodePartitions extracts Partitions=a,b from scontrol show node
+func parseNodePartitions(out string) []string {
+	for _, field := range strings.Fields(out) {
+		if value, ok := strings.CutPrefix(field, "Partitions="); ok && value != "" {
+			return strings.Split(value, ",")
+		}
+	}
+	return nil
+}
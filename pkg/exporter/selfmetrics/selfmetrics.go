@@ -0,0 +1,278 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package selfmetrics holds the exporter_* metrics describing the exporter
+// itself. They live in their own registry, never reset by config reloads
+// and never colliding with the GPU fields.
+package selfmetrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+)
+
+const (
+	namespace = "exporter"
+
+	// cache names
+	CacheGPU      = "gpu"
+	CacheProfiler = "profiler"
+	CacheBadPages = "bad_pages"
+
+	resultHit     = "hit"
+	resultMiss    = "miss"
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	scrapeDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "scrape_duration_seconds",
+		Help:      "Time taken to serve a metrics scrape",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"profile"})
+
+	collectionDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "collection_duration_seconds",
+		Help:      "Time taken by a background metrics collection",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	lastCollection = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_collection_timestamp_seconds",
+		Help:      "Unix time of the last completed metrics collection",
+	})
+
+	agentRPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "gpuagent_rpc_duration_seconds",
+		Help:      "Latency of the gpuagent RPCs",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	agentRPCErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gpuagent_rpc_errors_total",
+		Help:      "Number of failed gpuagent RPCs",
+	}, []string{"method"})
+
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Number of cache lookups by result",
+	}, []string{"cache", "result"})
+
+	profilerExecDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "profiler_exec_duration_seconds",
+		Help:      "Time taken by the rocpctl command",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	profilerExecFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "profiler_exec_failures_total",
+		Help:      "Number of failed rocpctl commands",
+	})
+
+	podResourcesDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "kubelet_podresources_duration_seconds",
+		Help:      "Latency of the kubelet pod resources List call",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	podResourcesErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kubelet_podresources_errors_total",
+		Help:      "Number of failed kubelet pod resources List calls",
+	})
+
+	configReloads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "config_reloads_total",
+		Help:      "Number of config reloads by result",
+	}, []string{"result"})
+
+	configLastReload = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "config_last_reload_success_timestamp_seconds",
+		Help:      "Time of the last successful config reload",
+	})
+
+	configValid = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "config_valid",
+		Help:      "Whether the last config file read passed validation",
+	})
+
+	configOverrideMatched = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "config_override_matched",
+		Help:      "Node overrides applied to the running config, always 1",
+	}, []string{"override"})
+
+	remoteWriteWALCorrupt = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "remote_write_wal_corrupt_records_total",
+		Help:      "Number of corrupt or undecodable remote write wal records skipped",
+	})
+
+	healthPollDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "health_poll_duration_seconds",
+		Help:      "Time taken by a gpu health poll",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	buildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "build_info",
+		Help:      "Exporter build information, always 1",
+	}, []string{"version", "git_commit", "build_date"})
+)
+
+func init() {
+	registry.MustRegister(scrapeDuration, collectionDuration, lastCollection, agentRPCDuration, agentRPCErrors,
+		cacheRequests, profilerExecDuration, profilerExecFailures, podResourcesDuration,
+		podResourcesErrors, configReloads, configLastReload, configValid, configOverrideMatched, remoteWriteWALCorrupt, healthPollDuration, buildInfo)
+	// report both results from the start so rate() works on the first failure
+	for _, cache := range []string{CacheGPU, CacheProfiler, CacheBadPages} {
+		cacheRequests.WithLabelValues(cache, resultHit)
+		cacheRequests.WithLabelValues(cache, resultMiss)
+	}
+	configReloads.WithLabelValues(resultSuccess)
+	configReloads.WithLabelValues(resultFailure)
+}
+
+// Gatherer returns the registry of the exporter metrics
+func Gatherer() prometheus.Gatherer {
+	return registry
+}
+
+// SetBuildInfo sets the build_info labels
+func SetBuildInfo(version, gitCommit, buildDate string) {
+	buildInfo.Reset()
+	buildInfo.WithLabelValues(version, gitCommit, buildDate).Set(1)
+}
+
+// ObserveScrape records the duration of a scrape, profile is empty for the
+// default route
+func ObserveScrape(profile string, start time.Time) {
+	scrapeDuration.WithLabelValues(profile).Observe(time.Since(start).Seconds())
+}
+
+// ObserveCollection records the duration of a background collection
+func ObserveCollection(start time.Time) {
+	collectionDuration.Observe(time.Since(start).Seconds())
+}
+
+// SetLastCollection records the time the served snapshot was collected
+func SetLastCollection(ts time.Time) {
+	lastCollection.Set(float64(ts.UnixNano()) / 1e9)
+}
+
+// ObserveAgentRPC records the latency and result of a gpuagent RPC
+func ObserveAgentRPC(method string, start time.Time, err error) {
+	agentRPCDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		agentRPCErrors.WithLabelValues(method).Inc()
+	}
+}
+
+// AgentUnaryInterceptor records every unary RPC made on the gpuagent
+// connection
+func AgentUnaryInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	ObserveAgentRPC(method, start, err)
+	return err
+}
+
+// CacheHit counts a lookup served from the cache
+func CacheHit(cache string) {
+	cacheRequests.WithLabelValues(cache, resultHit).Inc()
+}
+
+// CacheMiss counts a lookup that had to fetch new data
+func CacheMiss(cache string) {
+	cacheRequests.WithLabelValues(cache, resultMiss).Inc()
+}
+
+// ObserveProfilerExec records the duration and result of a rocpctl run
+func ObserveProfilerExec(start time.Time, err error) {
+	profilerExecDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		profilerExecFailures.Inc()
+	}
+}
+
+// ObservePodResources records the latency and result of a kubelet pod
+// resources List call
+func ObservePodResources(start time.Time, err error) {
+	podResourcesDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		podResourcesErrors.Inc()
+	}
+}
+
+// ConfigReloaded counts a config reload, successful ones update the last
+// success timestamp
+func ConfigReloaded(err error) {
+	if err != nil {
+		configReloads.WithLabelValues(resultFailure).Inc()
+		return
+	}
+	configReloads.WithLabelValues(resultSuccess).Inc()
+	configLastReload.SetToCurrentTime()
+}
+
+// SetConfigValid records the validation result of the last config read
+func SetConfigValid(valid bool) {
+	if valid {
+		configValid.Set(1)
+		return
+	}
+	configValid.Set(0)
+}
+
+// SetMatchedOverrides records the node overrides applied to the running
+// config
+func SetMatchedOverrides(names []string) {
+	configOverrideMatched.Reset()
+	for _, name := range names {
+		configOverrideMatched.WithLabelValues(name).Set(1)
+	}
+}
+
+// RemoteWriteWALCorrupt counts a remote write wal record skipped as corrupt
+func RemoteWriteWALCorrupt() {
+	remoteWriteWALCorrupt.Inc()
+}
+
+// ObserveHealthPoll records the duration of a gpu health poll
+func ObserveHealthPoll(start time.Time) {
+	healthPollDuration.Observe(time.Since(start).Seconds())
+}
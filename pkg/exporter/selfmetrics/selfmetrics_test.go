@@ -0,0 +1,108 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package selfmetrics
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func gather(t *testing.T) map[string]*dto.MetricFamily {
+	families, err := Gatherer().Gather()
+	assert.Assert(t, err == nil, "gather failed %v", err)
+	result := map[string]*dto.MetricFamily{}
+	for _, mf := range families {
+		result[mf.GetName()] = mf
+	}
+	return result
+}
+
+func labelValue(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+func TestSelfMetrics(t *testing.T) {
+	SetBuildInfo("v1", "abc", "today")
+	SetBuildInfo("v2", "def", "today")
+	families := gather(t)
+	info := families["exporter_build_info"].GetMetric()
+	assert.Equal(t, len(info), 1)
+	assert.Equal(t, labelValue(info[0], "version"), "v2")
+	assert.Equal(t, labelValue(info[0], "git_commit"), "def")
+
+	// rpc latency and errors by method
+	ok := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		return nil
+	}
+	fail := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		return fmt.Errorf("unavailable")
+	}
+	assert.Assert(t, AgentUnaryInterceptor(context.Background(), "/amdgpu.GPUSvc/GPUGet", nil, nil, nil, ok) == nil)
+	assert.Assert(t, AgentUnaryInterceptor(context.Background(), "/amdgpu.GPUSvc/GPUGet", nil, nil, nil, fail) != nil)
+	families = gather(t)
+	assert.Equal(t, families["exporter_gpuagent_rpc_duration_seconds"].GetMetric()[0].GetHistogram().GetSampleCount(), uint64(2))
+	assert.Equal(t, families["exporter_gpuagent_rpc_errors_total"].GetMetric()[0].GetCounter().GetValue(), float64(1))
+
+	// cache results are reported before the first lookup
+	assert.Equal(t, len(families["exporter_cache_requests_total"].GetMetric()), 6)
+	CacheHit(CacheGPU)
+	CacheMiss(CacheProfiler)
+	for _, m := range gather(t)["exporter_cache_requests_total"].GetMetric() {
+		hit := labelValue(m, "cache") == CacheGPU && labelValue(m, "result") == resultHit
+		miss := labelValue(m, "cache") == CacheProfiler && labelValue(m, "result") == resultMiss
+		if hit || miss {
+			assert.Equal(t, m.GetCounter().GetValue(), float64(1))
+		} else {
+			assert.Equal(t, m.GetCounter().GetValue(), float64(0))
+		}
+	}
+
+	// only successful reloads update the timestamp
+	ConfigReloaded(fmt.Errorf("invalid config"))
+	assert.Equal(t, gather(t)["exporter_config_last_reload_success_timestamp_seconds"].GetMetric()[0].GetGauge().GetValue(), float64(0))
+	before := time.Now()
+	ConfigReloaded(nil)
+	families = gather(t)
+	ts := families["exporter_config_last_reload_success_timestamp_seconds"].GetMetric()[0].GetGauge().GetValue()
+	assert.Assert(t, ts >= float64(before.Unix()), "timestamp %v not updated", ts)
+	for _, m := range families["exporter_config_reloads_total"].GetMetric() {
+		assert.Equal(t, m.GetCounter().GetValue(), float64(1))
+	}
+
+	SetConfigValid(true)
+	assert.Equal(t, gather(t)["exporter_config_valid"].GetMetric()[0].GetGauge().GetValue(), float64(1))
+	SetConfigValid(false)
+	assert.Equal(t, gather(t)["exporter_config_valid"].GetMetric()[0].GetGauge().GetValue(), float64(0))
+
+	// only the last matched overrides are reported
+	SetMatchedOverrides([]string{"a", "b"})
+	SetMatchedOverrides([]string{"b"})
+	matched := gather(t)["exporter_config_override_matched"].GetMetric()
+	assert.Equal(t, len(matched), 1)
+	assert.Equal(t, labelValue(matched[0], "override"), "b")
+}
@@ -0,0 +1,158 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// GatewayPrefix of the MetricsService gateway routes
+	GatewayPrefix = "/v1"
+
+	maxGatewayBody = 1 << 20
+)
+
+var (
+	gatewayMarshaler   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	gatewayUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// gatewayError is the body of the gateway error responses
+type gatewayError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// RegisterGatewayRoutes adds the HTTP/JSON mapping of the MetricsService
+// RPCs to the router, the requests are served in process by the same
+// service as the health socket
+//
+//	GET  /v1/gpustates            List, or GetGPUState with ?id=
+//	GET  /v1/gpustates/{id}       GetGPUState, ids are comma separated
+//	POST /v1/gpuerrors            SetError
+func (s *SvcHandler) RegisterGatewayRoutes(router *mux.Router) {
+	gw := router.PathPrefix(GatewayPrefix).Subrouter()
+	gw.HandleFunc("/gpustates", s.gatewayGPUStates).Methods(http.MethodGet)
+	gw.HandleFunc("/gpustates/{id}", s.gatewayGPUStates).Methods(http.MethodGet)
+	gw.HandleFunc("/gpuerrors", s.gatewaySetError).Methods(http.MethodPost)
+}
+
+func (s *SvcHandler) gatewayGPUStates(w http.ResponseWriter, r *http.Request) {
+	var ids []string
+	if id, ok := mux.Vars(r)["id"]; ok {
+		ids = strings.Split(id, ",")
+	} else {
+		ids = r.URL.Query()["id"]
+	}
+	var (
+		resp *metricssvc.GPUStateResponse
+		err  error
+	)
+	if len(ids) == 0 {
+		resp, err = s.healthSvc.List(r.Context(), &emptypb.Empty{})
+	} else {
+		resp, err = s.healthSvc.GetGPUState(r.Context(), &metricssvc.GPUGetRequest{ID: ids})
+	}
+	if err != nil {
+		writeGatewayError(w, gatewayStatus(err, http.StatusServiceUnavailable), err)
+		return
+	}
+	writeGatewayResponse(w, resp)
+}
+
+func (s *SvcHandler) gatewaySetError(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxGatewayBody))
+	if err != nil {
+		writeGatewayError(w, http.StatusBadRequest, fmt.Errorf("read request failed, %v", err))
+		return
+	}
+	req := &metricssvc.GPUErrorRequest{}
+	if err := gatewayUnmarshaler.Unmarshal(body, req); err != nil {
+		writeGatewayError(w, http.StatusBadRequest, fmt.Errorf("invalid request, %v", err))
+		return
+	}
+	resp, err := s.healthSvc.SetError(r.Context(), req)
+	if err != nil {
+		writeGatewayError(w, gatewayStatus(err, http.StatusBadRequest), err)
+		return
+	}
+	writeGatewayResponse(w, resp)
+}
+
+// gatewayStatus maps a gRPC status error to its HTTP code, plain errors
+// get the default code of the route
+func gatewayStatus(err error, def int) int {
+	st, ok := status.FromError(err)
+	if !ok {
+		return def
+	}
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unknown:
+		return def
+	}
+	return http.StatusInternalServerError
+}
+
+func writeGatewayResponse(w http.ResponseWriter, m proto.Message) {
+	data, err := gatewayMarshaler.Marshal(m)
+	if err != nil {
+		writeGatewayError(w, http.StatusInternalServerError, fmt.Errorf("marshal response failed, %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		logger.Log.Printf("gateway response write failed, %v", err)
+	}
+}
+
+func writeGatewayError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(&gatewayError{Code: code, Message: err.Error()}); err != nil {
+		logger.Log.Printf("gateway response write failed, %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+type fakeHealthClient struct {
+	states map[string]interface{}
+	err    error
+	set    []string
+}
+
+func (f *fakeHealthClient) GetGPUHealthStates() (map[string]interface{}, error) {
+	return f.states, f.err
+}
+
+func (f *fakeHealthClient) SetError(gpuid string, fields []string, values []uint32) error {
+	f.set = append(f.set, gpuid)
+	return nil
+}
+
+func newTestGateway(t *testing.T, debugAPI bool) (*fakeHealthClient, *mux.Router) {
+	logger.Init(true)
+	client := &fakeHealthClient{
+		states: map[string]interface{}{
+			"0": &metricssvc.GPUState{ID: "0", Health: "healthy"},
+			"1": &metricssvc.GPUState{ID: "1", Health: "unhealthy", HealthReasons: []string{"ecc"}},
+		},
+	}
+	s := InitSvcs(debugAPI, nil)
+	assert.Assert(t, s.RegisterHealthClient(client) == nil)
+	router := mux.NewRouter()
+	s.RegisterGatewayRoutes(router)
+	return client, router
+}
+
+func gatewayDo(router *mux.Router, method, url, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(method, url, strings.NewReader(body)))
+	return rec
+}
+
+func TestGatewayGPUStates(t *testing.T) {
+	client, router := newTestGateway(t, false)
+
+	rec := gatewayDo(router, http.MethodGet, "/v1/gpustates", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	var resp struct {
+		GPUState []struct {
+			ID            string   `json:"ID"`
+			Health        string   `json:"Health"`
+			HealthReasons []string `json:"HealthReasons"`
+		} `json:"GPUState"`
+	}
+	assert.Assert(t, json.Unmarshal(rec.Body.Bytes(), &resp) == nil)
+	assert.Equal(t, len(resp.GPUState), 2)
+
+	rec = gatewayDo(router, http.MethodGet, "/v1/gpustates/1", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Assert(t, json.Unmarshal(rec.Body.Bytes(), &resp) == nil)
+	assert.Equal(t, len(resp.GPUState), 1)
+	assert.Equal(t, resp.GPUState[0].Health, "unhealthy")
+	assert.DeepEqual(t, resp.GPUState[0].HealthReasons, []string{"ecc"})
+
+	rec = gatewayDo(router, http.MethodGet, "/v1/gpustates?id=0&id=1&id=7", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Assert(t, json.Unmarshal(rec.Body.Bytes(), &resp) == nil)
+	assert.Equal(t, len(resp.GPUState), 2)
+
+	client.err = fmt.Errorf("gpuagent down")
+	rec = gatewayDo(router, http.MethodGet, "/v1/gpustates", "")
+	assert.Equal(t, rec.Code, http.StatusServiceUnavailable)
+	assert.Assert(t, strings.Contains(rec.Body.String(), "gpuagent down"))
+}
+
+func TestGatewaySetError(t *testing.T) {
+	_, router := newTestGateway(t, false)
+	rec := gatewayDo(router, http.MethodPost, "/v1/gpuerrors", `{"ID":"0","Fields":["f"],"Counts":[1]}`)
+	assert.Equal(t, rec.Code, http.StatusBadRequest)
+
+	client, router := newTestGateway(t, true)
+	rec = gatewayDo(router, http.MethodPost, "/v1/gpuerrors", `{"ID":"0","Fields":["f"],"Counts":[1]}`)
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.DeepEqual(t, client.set, []string{"0"})
+
+	rec = gatewayDo(router, http.MethodPost, "/v1/gpuerrors", `{"ID":"0","Fields":["f"]}`)
+	assert.Equal(t, rec.Code, http.StatusBadRequest)
+
+	rec = gatewayDo(router, http.MethodPost, "/v1/gpuerrors", `not json`)
+	assert.Equal(t, rec.Code, http.StatusBadRequest)
+
+	rec = gatewayDo(router, http.MethodGet, "/v1/gpuerrors", "")
+	assert.Equal(t, rec.Code, http.StatusMethodNotAllowed)
+}
+
+func TestGatewayStatus(t *testing.T) {
+	assert.Equal(t, gatewayStatus(fmt.Errorf("plain"), http.StatusTeapot), http.StatusTeapot)
+	assert.Equal(t, gatewayStatus(status.Error(codes.NotFound, "x"), http.StatusTeapot), http.StatusNotFound)
+	assert.Equal(t, gatewayStatus(status.Error(codes.Internal, "x"), http.StatusTeapot), http.StatusInternalServerError)
+}
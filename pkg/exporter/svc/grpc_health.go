@@ -0,0 +1,70 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const healthCheckInterval = 5 * time.Second
+
+// MetricsServiceName is the grpc.health.v1 service name of MetricsService,
+// the overall "" status follows it
+var MetricsServiceName = metricssvc.MetricsService_ServiceDesc.ServiceName
+
+// updateServingStatus sets the grpc.health.v1 status from the health
+// clients checks
+func (s *SvcHandler) updateServingStatus(hs *health.Server) {
+	status := healthpb.HealthCheckResponse_SERVING
+	err := s.healthSvc.checkClients()
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	s.Lock()
+	changed := s.servingStatus != status
+	s.servingStatus = status
+	s.Unlock()
+	if changed {
+		if err != nil {
+			logger.Log.Printf("health service %v, %v", status, err)
+		} else {
+			logger.Log.Printf("health service %v", status)
+		}
+	}
+	hs.SetServingStatus("", status)
+	hs.SetServingStatus(MetricsServiceName, status)
+}
+
+// monitorServingStatus keeps the grpc.health.v1 status current until done
+func (s *SvcHandler) monitorServingStatus(hs *health.Server, done <-chan struct{}) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			s.updateServingStatus(hs)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+type checkedClient struct {
+	fakeHealthClient
+	checkErr error
+}
+
+func (c *checkedClient) CheckHealth() error {
+	return c.checkErr
+}
+
+func TestServingStatus(t *testing.T) {
+	logger.Init(true)
+	client := &checkedClient{}
+	s := InitSvcs(false, nil)
+	assert.Assert(t, s.RegisterHealthClient(client) == nil)
+	assert.Assert(t, s.RegisterHealthClient(&fakeHealthClient{}) == nil)
+	hs := health.NewServer()
+
+	check := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := hs.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.Assert(t, err == nil, "unexpected check error %v", err)
+		return resp.Status
+	}
+
+	s.updateServingStatus(hs)
+	assert.Equal(t, check(""), healthpb.HealthCheckResponse_SERVING)
+	assert.Equal(t, check(MetricsServiceName), healthpb.HealthCheckResponse_SERVING)
+
+	client.checkErr = fmt.Errorf("gpuagent connection TRANSIENT_FAILURE")
+	s.updateServingStatus(hs)
+	assert.Equal(t, check(""), healthpb.HealthCheckResponse_NOT_SERVING)
+	assert.Equal(t, check(MetricsServiceName), healthpb.HealthCheckResponse_NOT_SERVING)
+
+	client.checkErr = nil
+	s.updateServingStatus(hs)
+	assert.Equal(t, check(MetricsServiceName), healthpb.HealthCheckResponse_SERVING)
+}
@@ -16,6 +16,8 @@
 
 package metricsserver
 
+import "github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+
 type HealthInterface interface {
 	// Get health update of clients
 	GetGPUHealthStates() (map[string]interface{}, error)
@@ -24,6 +26,20 @@ type HealthInterface interface {
 	SetError(gpuid string, fields []string, values []uint32) error
 }
 
+// HealthWatcher is implemented by the health clients reporting the state
+// changes of their GPUs, notify is called with the changed states and the
+// ids of the removed GPUs in the order of the changes and must not block
+type HealthWatcher interface {
+	SetHealthWatch(notify func(states []*metricssvc.GPUState, removed []string))
+}
+
+// HealthChecker is implemented by the health clients able to tell whether
+// the GPU states they report are current, MetricsService is NOT_SERVING
+// in grpc.health.v1 while CheckHealth fails
+type HealthChecker interface {
+	CheckHealth() error
+}
+
 type HealthSvcServer interface {
 	// client Registration to the metrics svc server
 	RegisterHealthClient(HealthInterface) error
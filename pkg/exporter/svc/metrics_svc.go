@@ -30,7 +30,8 @@ type MetricsSvcImpl struct {
 	sync.Mutex
 	enableDebugAPI bool
 	metricssvc.UnimplementedMetricsServiceServer
-	clients []HealthInterface
+	clients  []HealthInterface
+	watchers *stateBroadcaster
 }
 
 func (m *MetricsSvcImpl) GetGPUState(ctx context.Context, req *metricssvc.GPUGetRequest) (*metricssvc.GPUStateResponse, error) {
@@ -105,11 +106,30 @@ func newMetricsServer(enableDebugAPI bool) *MetricsSvcImpl {
 	msrv := &MetricsSvcImpl{
 		enableDebugAPI: enableDebugAPI,
 		clients:        []HealthInterface{},
+		watchers:       newStateBroadcaster(),
 	}
 	return msrv
 }
 
+// checkClients returns the first failure of the health clients
+func (m *MetricsSvcImpl) checkClients() error {
+	m.Lock()
+	clients := append([]HealthInterface{}, m.clients...)
+	m.Unlock()
+	for _, client := range clients {
+		if hc, ok := client.(HealthChecker); ok {
+			if err := hc.CheckHealth(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (m *MetricsSvcImpl) RegisterHealthClient(client HealthInterface) error {
 	m.clients = append(m.clients, client)
+	if w, ok := client.(HealthWatcher); ok {
+		w.SetHealthWatch(m.watchers.publish)
+	}
 	return nil
 }
@@ -21,18 +21,31 @@ import (
 	"net"
 	"os"
 	"path"
+	"sync"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/metricsutil"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+// streams still open after this are closed by the stop
+const grpcStopTimeout = 5 * time.Second
+
 type SvcHandler struct {
+	sync.Mutex
 	grpc      *grpc.Server
 	healthSvc *MetricsSvcImpl
 	mh        *metricsutil.MetricsHandler
+	listening bool  // socket bound and served
+	runErr    error // last socket failure
+	health    *health.Server
+	// last grpc.health.v1 status of MetricsService
+	servingStatus healthpb.HealthCheckResponse_ServingStatus
 }
 
 func InitSvcs(enableDebugAPI bool, mh *metricsutil.MetricsHandler) *SvcHandler {
@@ -48,15 +61,67 @@ func (s *SvcHandler) RegisterHealthClient(client HealthInterface) error {
 	return s.healthSvc.RegisterHealthClient(client)
 }
 
+// Healthy returns an error when the enabled health socket isn't served
+func (s *SvcHandler) Healthy() error {
+	if s.mh != nil && !s.mh.GetHealthServiceState() {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.runErr != nil {
+		return s.runErr
+	}
+	if !s.listening {
+		return fmt.Errorf("health socket %v not listening", globals.MetricsSocketPath)
+	}
+	return nil
+}
+
+func (s *SvcHandler) setState(listening bool, err error) {
+	s.Lock()
+	defer s.Unlock()
+	s.listening = listening
+	s.runErr = err
+}
+
 func (s *SvcHandler) Stop() {
+	s.setState(false, nil)
+	s.Lock()
+	hs := s.health
+	s.health = nil
+	s.Unlock()
+	if hs != nil {
+		// health watchers see NOT_SERVING before the socket goes away
+		hs.Shutdown()
+	}
 	if s.grpc != nil {
 		logger.Log.Printf("stopping Health gRPC server")
-		s.grpc.GracefulStop()
+		// watch streams never end on their own
+		s.healthSvc.watchers.close()
+		stopped := make(chan struct{})
+		go func() {
+			s.grpc.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(grpcStopTimeout):
+			logger.Log.Printf("health gRPC server streams still open, closing them")
+			s.grpc.Stop()
+		}
 		s.grpc = nil
 	}
 }
 
 func (s *SvcHandler) Run() error {
+	err := s.run()
+	if err != nil {
+		s.setState(false, err)
+	}
+	return err
+}
+
+func (s *SvcHandler) run() error {
 	if s.mh != nil {
 		if enabled := s.mh.GetHealthServiceState(); !enabled {
 			logger.Log.Printf("health service is disabled")
@@ -68,6 +133,7 @@ func (s *SvcHandler) Run() error {
 		logger.Log.Printf("creating new gRPC server")
 		s.grpc = grpc.NewServer()
 	}
+	s.healthSvc.watchers.open()
 
 	socketPath := globals.MetricsSocketPath
 	// Remove any existing socket file
@@ -89,9 +155,19 @@ func (s *SvcHandler) Run() error {
 		logger.Log.Printf("socket %v chmod to 777 failed, set it on host", socketPath)
 	}
 	logger.Log.Printf("listening on socket %v", socketPath)
+	s.setState(true, nil)
 
 	// server registration for grpc services
 	metricssvc.RegisterMetricsServiceServer(s.grpc, s.healthSvc)
+	hs := health.NewServer()
+	s.updateServingStatus(hs)
+	healthpb.RegisterHealthServer(s.grpc, hs)
+	s.Lock()
+	s.health = hs
+	s.Unlock()
+	done := make(chan struct{})
+	defer close(done)
+	go s.monitorServingStatus(hs, done)
 
 	if err := s.grpc.Serve(lis); err != nil {
 		return fmt.Errorf("failed to serve: %v", err)
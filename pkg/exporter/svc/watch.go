@@ -0,0 +1,197 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// deltas buffered per subscriber, a subscriber falling further behind is
+// sent a new snapshot instead
+const watchBufferSize = 64
+
+type stateSubscriber struct {
+	ids      map[string]bool // watched GPUs, all when empty
+	ch       chan *metricssvc.GPUStateWatchResponse
+	overflow chan struct{}
+	done     chan struct{}
+}
+
+// stateBroadcaster fans the state changes of the health clients out to the
+// WatchGPUState streams
+type stateBroadcaster struct {
+	sync.Mutex
+	subs   map[*stateSubscriber]bool
+	closed bool
+}
+
+func newStateBroadcaster() *stateBroadcaster {
+	return &stateBroadcaster{subs: map[*stateSubscriber]bool{}}
+}
+
+func (b *stateBroadcaster) subscribe(ids []string) (*stateSubscriber, error) {
+	b.Lock()
+	defer b.Unlock()
+	if b.closed {
+		return nil, status.Error(codes.Unavailable, "health service is stopping")
+	}
+	sub := &stateSubscriber{
+		ids:      map[string]bool{},
+		ch:       make(chan *metricssvc.GPUStateWatchResponse, watchBufferSize),
+		overflow: make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	for _, id := range ids {
+		sub.ids[id] = true
+	}
+	b.subs[sub] = true
+	return sub, nil
+}
+
+func (b *stateBroadcaster) unsubscribe(sub *stateSubscriber) {
+	b.Lock()
+	defer b.Unlock()
+	if b.subs[sub] {
+		delete(b.subs, sub)
+		close(sub.done)
+	}
+}
+
+// publish never blocks, the changes are dropped for a subscriber with a
+// full buffer and it is flagged for a new snapshot
+func (b *stateBroadcaster) publish(states []*metricssvc.GPUState, removed []string) {
+	b.Lock()
+	defer b.Unlock()
+	for sub := range b.subs {
+		delta := &metricssvc.GPUStateWatchResponse{GPUState: states, RemovedID: removed}
+		if len(sub.ids) != 0 {
+			delta = &metricssvc.GPUStateWatchResponse{}
+			for _, state := range states {
+				if sub.ids[state.ID] {
+					delta.GPUState = append(delta.GPUState, state)
+				}
+			}
+			for _, id := range removed {
+				if sub.ids[id] {
+					delta.RemovedID = append(delta.RemovedID, id)
+				}
+			}
+		}
+		if len(delta.GPUState) == 0 && len(delta.RemovedID) == 0 {
+			continue
+		}
+		select {
+		case sub.ch <- delta:
+		default:
+			select {
+			case sub.overflow <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// close ends the streams so the gRPC server can stop gracefully, new
+// streams are refused until open
+func (b *stateBroadcaster) close() {
+	b.Lock()
+	defer b.Unlock()
+	b.closed = true
+	for sub := range b.subs {
+		delete(b.subs, sub)
+		close(sub.done)
+	}
+}
+
+func (b *stateBroadcaster) open() {
+	b.Lock()
+	defer b.Unlock()
+	b.closed = false
+}
+
+// snapshot returns copies of the current states of the watched GPUs, empty
+// until the health clients have a state
+func (m *MetricsSvcImpl) snapshot(ctx context.Context, ids []string) []*metricssvc.GPUState {
+	var (
+		resp *metricssvc.GPUStateResponse
+		err  error
+	)
+	if len(ids) == 0 {
+		resp, err = m.List(ctx, &emptypb.Empty{})
+	} else {
+		resp, err = m.GetGPUState(ctx, &metricssvc.GPUGetRequest{ID: ids})
+	}
+	if err != nil {
+		logger.Log.Printf("watch snapshot not available, %v", err)
+		return []*metricssvc.GPUState{}
+	}
+	states := make([]*metricssvc.GPUState, 0, len(resp.GPUState))
+	for _, state := range resp.GPUState {
+		states = append(states, proto.Clone(state).(*metricssvc.GPUState))
+	}
+	return states
+}
+
+func (m *MetricsSvcImpl) WatchGPUState(req *metricssvc.GPUGetRequest, stream metricssvc.MetricsService_WatchGPUStateServer) error {
+	ctx := stream.Context()
+	// subscribe first, changes racing with the snapshot are sent again
+	// after it
+	sub, err := m.watchers.subscribe(req.ID)
+	if err != nil {
+		return err
+	}
+	defer m.watchers.unsubscribe(sub)
+
+	sendSnapshot := func() error {
+		return stream.Send(&metricssvc.GPUStateWatchResponse{
+			Snapshot: true,
+			GPUState: m.snapshot(ctx, req.ID),
+		})
+	}
+	if err := sendSnapshot(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.done:
+			return status.Error(codes.Unavailable, "health service is stopping")
+		case <-sub.overflow:
+			logger.Log.Printf("watch consumer is too slow, changes dropped, resending the snapshot")
+			for len(sub.ch) > 0 {
+				<-sub.ch
+			}
+			if err := sendSnapshot(); err != nil {
+				return err
+			}
+		case delta := <-sub.ch:
+			if err := stream.Send(delta); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package metricsserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+type watchClient struct {
+	fakeHealthClient
+	notify func(states []*metricssvc.GPUState, removed []string)
+}
+
+func (w *watchClient) SetHealthWatch(notify func(states []*metricssvc.GPUState, removed []string)) {
+	w.notify = notify
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs chan *metricssvc.GPUStateWatchResponse
+}
+
+func (f *fakeWatchStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchStream) Send(m *metricssvc.GPUStateWatchResponse) error {
+	f.msgs <- m
+	return nil
+}
+
+func (f *fakeWatchStream) recv(t *testing.T) *metricssvc.GPUStateWatchResponse {
+	select {
+	case m := <-f.msgs:
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("no watch message received")
+	}
+	return nil
+}
+
+func startWatch(t *testing.T, m *MetricsSvcImpl, ids []string, buffer int) (*fakeWatchStream, context.CancelFunc, chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchStream{ctx: ctx, msgs: make(chan *metricssvc.GPUStateWatchResponse, buffer)}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- m.WatchGPUState(&metricssvc.GPUGetRequest{ID: ids}, stream)
+	}()
+	// the snapshot is sent once subscribed
+	snap := stream.recv(t)
+	assert.Assert(t, snap.Snapshot)
+	return stream, cancel, errCh
+}
+
+func TestWatchGPUState(t *testing.T) {
+	logger.Init(true)
+	client := &watchClient{fakeHealthClient: fakeHealthClient{
+		states: map[string]interface{}{
+			"0": &metricssvc.GPUState{ID: "0", Health: "healthy"},
+			"1": &metricssvc.GPUState{ID: "1", Health: "healthy"},
+		},
+	}}
+	m := newMetricsServer(false)
+	assert.Assert(t, m.RegisterHealthClient(client) == nil)
+	assert.Assert(t, client.notify != nil, "expecting health watch set")
+
+	all, cancelAll, allErr := startWatch(t, m, nil, 1)
+	one, cancelOne, _ := startWatch(t, m, []string{"1"}, 1)
+	defer cancelOne()
+
+	client.notify([]*metricssvc.GPUState{{ID: "0", Health: "unhealthy"}}, nil)
+	msg := all.recv(t)
+	assert.Assert(t, !msg.Snapshot)
+	assert.Equal(t, msg.GPUState[0].ID, "0")
+	assert.Equal(t, msg.GPUState[0].Health, "unhealthy")
+
+	// only the watched GPUs are sent
+	client.notify([]*metricssvc.GPUState{{ID: "1", Health: "unhealthy"}}, nil)
+	msg = one.recv(t)
+	assert.Equal(t, len(msg.GPUState), 1)
+	assert.Equal(t, msg.GPUState[0].ID, "1")
+	assert.Equal(t, all.recv(t).GPUState[0].ID, "1")
+
+	// removed GPUs are sent to their watchers only
+	client.notify(nil, []string{"0"})
+	msg = all.recv(t)
+	assert.Equal(t, len(msg.GPUState), 0)
+	assert.DeepEqual(t, msg.RemovedID, []string{"0"})
+	client.notify(nil, []string{"1"})
+	assert.DeepEqual(t, one.recv(t).RemovedID, []string{"1"})
+	assert.DeepEqual(t, all.recv(t).RemovedID, []string{"1"})
+
+	cancelAll()
+	assert.Assert(t, <-allErr == nil)
+
+	// closing the service ends the streams and refuses new ones
+	m.watchers.close()
+	stream := &fakeWatchStream{ctx: context.Background(), msgs: make(chan *metricssvc.GPUStateWatchResponse, 1)}
+	err := m.WatchGPUState(&metricssvc.GPUGetRequest{}, stream)
+	assert.Equal(t, status.Code(err), codes.Unavailable)
+	m.watchers.open()
+}
+
+func TestWatchSlowConsumer(t *testing.T) {
+	logger.Init(true)
+	m := newMetricsServer(false)
+	sub, err := m.watchers.subscribe(nil)
+	assert.Assert(t, err == nil)
+	for i := 0; i < watchBufferSize+1; i++ {
+		m.watchers.publish([]*metricssvc.GPUState{{ID: "0"}}, nil)
+	}
+	assert.Equal(t, len(sub.ch), watchBufferSize)
+	select {
+	case <-sub.overflow:
+	default:
+		t.Fatal("expecting the subscriber flagged for a snapshot")
+	}
+	m.watchers.unsubscribe(sub)
+	// publish after unsubscribe is a no-op
+	m.watchers.publish([]*metricssvc.GPUState{{ID: "0"}}, nil)
+}
@@ -0,0 +1,169 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package exporter
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// certReloader holds the server certificate and client CA pool, Reload
+// swaps them for new handshakes without restarting the listener
+type certReloader struct {
+	sync.RWMutex
+	conf      *exportermetrics.TLSConfig
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func newCertReloader(conf *exportermetrics.TLSConfig) (*certReloader, error) {
+	r := &certReloader{}
+	if err := r.SetConfig(conf); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// SetConfig switches to the certificates of a new config, the current ones
+// are kept on failure
+func (r *certReloader) SetConfig(conf *exportermetrics.TLSConfig) error {
+	if conf.GetCertFile() == "" || conf.GetKeyFile() == "" {
+		return fmt.Errorf("TLS configured without CertFile/KeyFile")
+	}
+	if err := r.load(conf); err != nil {
+		return err
+	}
+	if conf.GetClientCAFile() == "" {
+		logger.Log.Printf("no ClientCAFile configured, /debug endpoints are disabled")
+	}
+	return nil
+}
+
+// Reload reads the certificate files again, the current ones are kept on
+// failure
+func (r *certReloader) Reload() error {
+	r.RLock()
+	conf := r.conf
+	r.RUnlock()
+	return r.load(conf)
+}
+
+func (r *certReloader) load(conf *exportermetrics.TLSConfig) error {
+	cert, err := tls.LoadX509KeyPair(conf.GetCertFile(), conf.GetKeyFile())
+	if err != nil {
+		return fmt.Errorf("failed to load certificate %v, %v", conf.GetCertFile(), err)
+	}
+	var clientCAs *x509.CertPool
+	if caFile := conf.GetClientCAFile(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA %v, %v", caFile, err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no valid certificates found in client CA %v", caFile)
+		}
+	}
+	r.Lock()
+	r.conf = conf
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.Unlock()
+	logger.Log.Printf("loaded TLS certificate %v", conf.GetCertFile())
+	return nil
+}
+
+// certWatchDirs returns the directories holding the certificate files, files
+// are watched through their directory to catch k8s secret symlink swaps
+func certWatchDirs(conf *exportermetrics.TLSConfig) []string {
+	dirs := []string{}
+	seen := map[string]bool{}
+	for _, f := range []string{conf.GetCertFile(), conf.GetKeyFile(), conf.GetClientCAFile()} {
+		if f == "" || seen[path.Dir(f)] {
+			continue
+		}
+		seen[path.Dir(f)] = true
+		dirs = append(dirs, path.Dir(f))
+	}
+	return dirs
+}
+
+func (r *certReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.RLock()
+			defer r.RUnlock()
+			c := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+			// RequireClientCert is enforced per route, the kubelet probes
+			// have no client certificate
+			if r.clientCAs != nil {
+				c.ClientCAs = r.clientCAs
+				c.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+			return c, nil
+		},
+	}
+}
+
+// requireClientCert returns true when every route but the probes requires a
+// verified client certificate
+func (r *certReloader) requireClientCert() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.clientCAs != nil && r.conf.GetRequireClientCert()
+}
+
+// clientCertMiddleware rejects requests without a verified client
+// certificate, plain HTTP is left as is
+func clientCertMiddleware(certs *certReloader) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if certs != nil && (r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+				http.Error(w, "client certificate required", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requireClientCertMiddleware rejects requests without a verified client
+// certificate when RequireClientCert is set
+func requireClientCertMiddleware(certs *certReloader) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		verified := clientCertMiddleware(certs)(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if certs != nil && certs.requireClientCert() {
+				verified.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package exporter
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// testCA signs the server and client certificates of the tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Assert(t, err == nil)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.Assert(t, err == nil)
+	cert, err := x509.ParseCertificate(der)
+	assert.Assert(t, err == nil)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM certificate and key of a leaf signed by the CA
+func (ca *testCA) issue(t *testing.T, serial int64, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Assert(t, err == nil)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "exporter"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.Assert(t, err == nil)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Assert(t, err == nil)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+// writeServerCert writes a server certificate with the serial to the TLS
+// config files
+func writeServerCert(t *testing.T, ca *testCA, conf *exportermetrics.TLSConfig, serial int64) {
+	cert, key := ca.issue(t, serial, x509.ExtKeyUsageServerAuth)
+	assert.Assert(t, os.WriteFile(conf.CertFile, cert, 0644) == nil)
+	assert.Assert(t, os.WriteFile(conf.KeyFile, key, 0600) == nil)
+}
+
+func newTestTLSConfig(t *testing.T, ca *testCA) *exportermetrics.TLSConfig {
+	dir := t.TempDir()
+	conf := &exportermetrics.TLSConfig{
+		CertFile:     filepath.Join(dir, "tls.crt"),
+		KeyFile:      filepath.Join(dir, "tls.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+	}
+	assert.Assert(t, os.WriteFile(conf.ClientCAFile, ca.pem, 0644) == nil)
+	writeServerCert(t, ca, conf, 10)
+	return conf
+}
+
+// testTLSClient trusts the CA and presents the client certificates
+func testTLSClient(ca *testCA, certs ...tls.Certificate) *http.Client {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			DisableKeepAlives: true,
+			TLSClientConfig:   &tls.Config{RootCAs: pool, Certificates: certs},
+		},
+	}
+}
+
+// servedSerial returns the serial of the certificate served on the address
+func servedSerial(t *testing.T, ca *testCA, addr string) int64 {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	conn, err := tls.Dial("tcp", addr, &tls.Config{RootCAs: pool})
+	assert.Assert(t, err == nil)
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+}
+
+func TestCertReloader(t *testing.T) {
+	logger.Init(true)
+	ca := newTestCA(t)
+	conf := newTestTLSConfig(t, ca)
+	r, err := newCertReloader(conf)
+	assert.Assert(t, err == nil)
+	serial := func() int64 {
+		c, err := r.tlsConfig().GetConfigForClient(nil)
+		assert.Assert(t, err == nil)
+		leaf, err := x509.ParseCertificate(c.Certificates[0].Certificate[0])
+		assert.Assert(t, err == nil)
+		return leaf.SerialNumber.Int64()
+	}
+	assert.Equal(t, serial(), int64(10))
+
+	writeServerCert(t, ca, conf, 11)
+	assert.Assert(t, r.Reload() == nil)
+	assert.Equal(t, serial(), int64(11))
+
+	// a bad certificate keeps the previous one
+	assert.Assert(t, os.WriteFile(conf.CertFile, []byte("bad"), 0644) == nil)
+	assert.Assert(t, r.Reload() != nil)
+	assert.Equal(t, serial(), int64(11))
+
+	// missing files on a new config keep the previous one as well
+	assert.Assert(t, r.SetConfig(&exportermetrics.TLSConfig{CertFile: "/nonexistent", KeyFile: "/nonexistent"}) != nil)
+	assert.Equal(t, serial(), int64(11))
+}
+
+func TestTLSHotReload(t *testing.T) {
+	ca := newTestCA(t)
+	tlsConf := newTestTLSConfig(t, ca)
+	_, addr := startTestExporter(t, &exportermetrics.MetricConfig{TLS: tlsConf})
+
+	client := testTLSClient(ca)
+	resp := waitServed(t, client, "https://"+addr+"/metrics")
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+	assert.Equal(t, servedSerial(t, ca, addr), int64(10))
+
+	// the rotated certificate is served without restarting the listener
+	writeServerCert(t, ca, tlsConf, 11)
+	deadline := time.Now().Add(5 * time.Second)
+	for servedSerial(t, ca, addr) != 11 {
+		assert.Assert(t, time.Now().Before(deadline), "rotated certificate not served")
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestTLSClientCert(t *testing.T) {
+	ca := newTestCA(t)
+	tlsConf := newTestTLSConfig(t, ca)
+	tlsConf.RequireClientCert = true
+	_, addr := startTestExporter(t, &exportermetrics.MetricConfig{TLS: tlsConf})
+
+	certPEM, keyPEM := ca.issue(t, 20, x509.ExtKeyUsageClientAuth)
+	clientCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	assert.Assert(t, err == nil)
+	withCert := testTLSClient(ca, clientCert)
+	withoutCert := testTLSClient(ca)
+
+	resp := waitServed(t, withCert, "https://"+addr+"/metrics")
+	resp.Body.Close()
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+
+	for path, code := range map[string]int{
+		"/metrics":    http.StatusForbidden,
+		"/debug/vars": http.StatusForbidden,
+		// the kubelet probes have no client certificate
+		"/healthz": http.StatusOK,
+		"/readyz":  http.StatusOK,
+	} {
+		resp, err := withoutCert.Get("https://" + addr + path)
+		assert.Assert(t, err == nil, "%v: %v", path, err)
+		resp.Body.Close()
+		assert.Equal(t, resp.StatusCode, code, path)
+	}
+
+	// a certificate of another CA fails the handshake
+	otherPEM, otherKey := newTestCA(t).issue(t, 30, x509.ExtKeyUsageClientAuth)
+	otherCert, err := tls.X509KeyPair(otherPEM, otherKey)
+	assert.Assert(t, err == nil)
+	_, err = testTLSClient(ca, otherCert).Get("https://" + addr + "/metrics")
+	assert.Assert(t, err != nil)
+}
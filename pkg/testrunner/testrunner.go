@@ -34,7 +34,10 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -664,8 +667,7 @@ func (tr *TestRunner) watchGPUState() {
 	}
 
 	c := metricssvc.NewMetricsServiceClient(conn)
-	watchTicker := time.NewTicker(globals.GPUStateWatchFreq)
-	defer watchTicker.Stop()
+	hc := healthpb.NewHealthClient(conn)
 
 	// handle test runner crash or restart
 	// read existing test runner status db
@@ -704,7 +706,113 @@ func (tr *TestRunner) watchGPUState() {
 	}
 
 	go tr.watchConfigFile()
+	for {
+		err := tr.streamGPUState(c, hc)
+		if status.Code(err) == codes.Unimplemented {
+			// exporter without the watch API
+			logger.Log.Printf("GPU state watch not supported by the exporter, polling every %v", globals.GPUStateWatchFreq)
+			tr.pollGPUState(c, hc)
+		}
+		logger.Log.Printf("GPU state watch failed: %v, retrying in %v", err, globals.GPUStateConnRetryFreq)
+		time.Sleep(globals.GPUStateConnRetryFreq)
+	}
+}
+
+// exporterServing checks the grpc.health.v1 status of the exporter, the
+// GPU states of a wedged or NOT_SERVING exporter aren't acted on. Exporters
+// without the health service are assumed serving.
+func (tr *TestRunner) exporterServing(hc healthpb.HealthClient) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), globals.GPUStateReqTimeout)
+	defer cancel()
+	resp, err := hc.Check(ctx, &healthpb.HealthCheckRequest{Service: metricssvc.MetricsService_ServiceDesc.ServiceName})
+	if status.Code(err) == codes.Unimplemented {
+		return true
+	}
+	if err != nil {
+		logger.Log.Printf("exporter health check failed: %v, skip GPU states", err)
+		return false
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		logger.Log.Printf("exporter health status %v, skip GPU states", resp.Status)
+		return false
+	}
+	return true
+}
+
+// streamGPUState follows the GPU state changes streamed by the exporter and
+// handles the state of all the GPUs on every change, states skipped while
+// the exporter isn't serving are handled again every GPUStateWatchFreq. It
+// returns when the stream breaks.
+func (tr *TestRunner) streamGPUState(c metricssvc.MetricsServiceClient, hc healthpb.HealthClient) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := c.WatchGPUState(ctx, &metricssvc.GPUGetRequest{})
+	if err != nil {
+		return err
+	}
+	msgs := make(chan *metricssvc.GPUStateWatchResponse)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			r, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case msgs <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	retryTicker := time.NewTicker(globals.GPUStateWatchFreq)
+	defer retryTicker.Stop()
+
+	states := map[string]*metricssvc.GPUState{}
+	pending := false
+	handle := func() {
+		if pending = !tr.exporterServing(hc); pending {
+			return
+		}
+		gpuStates := make([]*metricssvc.GPUState, 0, len(states))
+		for _, state := range states {
+			gpuStates = append(gpuStates, state)
+		}
+		tr.handleGPUStates(gpuStates)
+	}
+	for {
+		select {
+		case err := <-errCh:
+			return err
+		case r := <-msgs:
+			logger.Log.Printf("GPU State: %s", r.String())
+			if r.Snapshot {
+				states = map[string]*metricssvc.GPUState{}
+			}
+			for _, state := range r.GPUState {
+				states[state.ID] = state
+			}
+			for _, id := range r.RemovedID {
+				delete(states, id)
+			}
+			handle()
+		case <-retryTicker.C:
+			if pending {
+				handle()
+			}
+		}
+	}
+}
+
+// pollGPUState lists the GPU states every GPUStateWatchFreq
+func (tr *TestRunner) pollGPUState(c metricssvc.MetricsServiceClient, hc healthpb.HealthClient) {
+	watchTicker := time.NewTicker(globals.GPUStateWatchFreq)
+	defer watchTicker.Stop()
 	for range watchTicker.C {
+		if !tr.exporterServing(hc) {
+			continue
+		}
 		ctx, cancel := context.WithTimeout(context.Background(), globals.GPUStateReqTimeout)
 		r, err := c.List(ctx, &emptypb.Empty{})
 		if err != nil {
@@ -714,34 +822,37 @@ func (tr *TestRunner) watchGPUState() {
 		}
 		logger.Log.Printf("GPU State: %s", r.String())
 		cancel()
+		tr.handleGPUStates(r.GPUState)
+	}
+}
 
-		healthyGPUIDs := []string{}
-		unHealthyGPUIDs := []string{}
-		if r != nil {
-			for _, state := range r.GPUState {
-				// if any GPU is not healthy, start a test against those GPUs
-				if !strings.EqualFold(state.Health, metricssvc.GPUHealth_HEALTHY.String()) {
-					if len(state.AssociatedWorkload) == 0 {
-						unHealthyGPUIDs = append(unHealthyGPUIDs, state.ID)
-					} else {
-						logger.Log.Printf("found GPU %+v unhealthy but still associated with workload %+v", state.ID, state.AssociatedWorkload)
-					}
-				} else {
-					healthyGPUIDs = append(healthyGPUIDs, state.ID)
-				}
+// handleGPUStates starts a test on the unhealthy GPUs without workload and
+// cleans up the test status of the healthy ones
+func (tr *TestRunner) handleGPUStates(states []*metricssvc.GPUState) {
+	healthyGPUIDs := []string{}
+	unHealthyGPUIDs := []string{}
+	for _, state := range states {
+		// if any GPU is not healthy, start a test against those GPUs
+		if !strings.EqualFold(state.Health, metricssvc.GPUHealth_HEALTHY.String()) {
+			if len(state.AssociatedWorkload) == 0 {
+				unHealthyGPUIDs = append(unHealthyGPUIDs, state.ID)
+			} else {
+				logger.Log.Printf("found GPU %+v unhealthy but still associated with workload %+v", state.ID, state.AssociatedWorkload)
 			}
-		}
-
-		// start test on unhealthy GPU
-		if len(unHealthyGPUIDs) > 0 {
-			logger.Log.Printf("found GPU with unhealthy state %+v", unHealthyGPUIDs)
-			go tr.testGPU(testrunnerGen.TestTrigger_AUTO_UNHEALTHY_GPU_WATCH.String(), unHealthyGPUIDs, false)
 		} else {
-			logger.Log.Printf("all GPUs are healthy or associated with workloads, skip testing")
+			healthyGPUIDs = append(healthyGPUIDs, state.ID)
 		}
+	}
 
-		tr.cleanupHealthyGPUTestStatus(healthyGPUIDs)
+	// start test on unhealthy GPU
+	if len(unHealthyGPUIDs) > 0 {
+		logger.Log.Printf("found GPU with unhealthy state %+v", unHealthyGPUIDs)
+		go tr.testGPU(testrunnerGen.TestTrigger_AUTO_UNHEALTHY_GPU_WATCH.String(), unHealthyGPUIDs, false)
+	} else {
+		logger.Log.Printf("all GPUs are healthy or associated with workloads, skip testing")
 	}
+
+	tr.cleanupHealthyGPUTestStatus(healthyGPUIDs)
 }
 
 func (tr *TestRunner) watchConfigFile() {
@@ -92,7 +92,8 @@ func TestGetHostName(t *testing.T) {
 }
 
 func TestGetOverallResult(t *testing.T) {
-	tr := &TestRunner{}
+	// log to the test directory rather than the working directory
+	tr := &TestRunner{logDir: t.TempDir()}
 	tr.initLogger()
 	validIDs := []string{"gpu0", "gpu1"}
 
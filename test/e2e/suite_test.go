@@ -220,8 +220,8 @@ func (s *E2ESuite) SetUpSuite(c *C) {
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	}
 	s.exporterClient = &http.Client{Transport: tr}
-	// empty config is default
-	err = s.WriteConfig(&exportermetrics.MetricConfig{})
+	// empty config is default, served over plain HTTP
+	err = s.WriteConfig(&exportermetrics.MetricConfig{AllowPlainHTTP: true})
 	assert.Nil(c, err)
 	log.Printf("SetUpSuite done with config path :%v", exporterConfigPath)
 }